@@ -2,21 +2,47 @@ package main
 
 import (
 	"github.com/gin-gonic/gin"
+	"hexagonal/src/adapters/clock"
 	"hexagonal/src/adapters/http"
+	"hexagonal/src/adapters/random"
 	"hexagonal/src/adapters/repositories/memory_kvs"
 	"hexagonal/src/config/uuid"
 	"hexagonal/src/core/usecases"
+	"log"
+	"os"
 )
 
 func main() {
 	gameRepositoryPort := memory_kvs.NewMemKVS()
-	gameUseCase := usecases.New(gameRepositoryPort, uuid.New())
+	gameUseCase := usecases.New(gameRepositoryPort, uuid.New(), clock.NewSystemClock(), random.NewCryptoRandom())
 	gameUsingHttp := http.NewHTTPHandler(gameUseCase)
 
 	router := gin.New()
 	router.GET("/games/:id", gameUsingHttp.Get)
+	router.GET("/games/:id/replay", gameUsingHttp.Replay)
 	router.POST("/games", gameUsingHttp.Create)
 	router.PUT("/games/:id", gameUsingHttp.RevealCell)
+	router.PUT("/games/:id/flag", gameUsingHttp.FlagCell)
+	router.PUT("/games/:id/mark", gameUsingHttp.MarkCell)
+	router.PUT("/games/:id/chord", gameUsingHttp.ChordCell)
+	router.PUT("/games/:id/hint", gameUsingHttp.Hint)
+	router.PUT("/games/:id/heatmap", gameUsingHttp.Heatmap)
+	router.PUT("/games/:id/undo", gameUsingHttp.Undo)
+	router.PUT("/games/:id/pause", gameUsingHttp.Pause)
+	router.PUT("/games/:id/resume", gameUsingHttp.Resume)
+	router.PUT("/games/:id/abandon", gameUsingHttp.Abandon)
+
+	// Without a secret anybody could work out the daily boards from their dates.
+	if dailySecret := os.Getenv("DAILY_SECRET"); dailySecret != "" {
+		challengeRepositoryPort := memory_kvs.NewChallengeMemKVS()
+		dailyUseCase := usecases.NewDaily(gameRepositoryPort, challengeRepositoryPort, uuid.New(), clock.NewSystemClock(), dailySecret)
+		dailyUsingHttp := http.NewDailyHTTPHandler(dailyUseCase)
+
+		router.POST("/daily", dailyUsingHttp.Play)
+		router.GET("/daily/:day/results", dailyUsingHttp.Results)
+	} else {
+		log.Println("warning: DAILY_SECRET is not set, the daily challenge is disabled")
+	}
 
 	router.Run(":8080")
 }
@@ -0,0 +1,40 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	"hexagonal/src/core/dto"
+	"hexagonal/src/core/ports"
+)
+
+type daily struct {
+	dailyPort ports.DailyPort
+}
+
+func NewDailyHTTPHandler(dailyUseCase ports.DailyPort) *daily {
+	return &daily{
+		dailyPort: dailyUseCase,
+	}
+}
+
+func (handler *daily) Play(c *gin.Context) {
+	body := dto.BodyDaily{}
+	c.BindJSON(&body)
+
+	game, err := handler.dailyPort.Play(body.Player)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseDaily(game))
+}
+
+func (handler *daily) Results(c *gin.Context) {
+	results, err := handler.dailyPort.Results(c.Param("day"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseDailyResults(c.Param("day"), results))
+}
@@ -23,14 +23,14 @@ func (handler *http) Get(c *gin.Context) {
 		return
 	}
 
-	c.JSON(200, game)
+	c.JSON(200, dto.BuildResponseGet(game))
 }
 
 func (handler *http) Create(c *gin.Context) {
 	body := dto.BodyCreate{}
 	c.BindJSON(&body)
 
-	game, err := handler.gamePort.Create(body.Name, body.Size, body.Bombs)
+	game, err := handler.gamePort.Create(body.Name, body.Settings())
 	if err != nil {
 		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
 		return
@@ -51,3 +51,115 @@ func (handler *http) RevealCell(c *gin.Context) {
 
 	c.JSON(200, dto.BuildResponseRevealCell(game))
 }
+
+func (handler *http) FlagCell(c *gin.Context) {
+	body := dto.BodyFlagCell{}
+	c.BindJSON(&body)
+
+	game, err := handler.gamePort.Flag(c.Param("id"), body.Row, body.Col)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseFlagCell(game))
+}
+
+func (handler *http) MarkCell(c *gin.Context) {
+	body := dto.BodyMarkCell{}
+	c.BindJSON(&body)
+
+	game, err := handler.gamePort.Mark(c.Param("id"), body.Row, body.Col)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseMarkCell(game))
+}
+
+func (handler *http) ChordCell(c *gin.Context) {
+	body := dto.BodyChordCell{}
+	c.BindJSON(&body)
+
+	game, err := handler.gamePort.Chord(c.Param("id"), body.Row, body.Col)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseChordCell(game))
+}
+
+func (handler *http) Hint(c *gin.Context) {
+	hint, err := handler.gamePort.Hint(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseHint(hint))
+}
+
+func (handler *http) Heatmap(c *gin.Context) {
+	heatmap, err := handler.gamePort.Heatmap(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseHeatmap(heatmap))
+}
+
+func (handler *http) Undo(c *gin.Context) {
+	body := dto.BodyUndo{}
+	c.BindJSON(&body)
+
+	game, err := handler.gamePort.Undo(c.Param("id"), body.Moves)
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseUndo(game))
+}
+
+func (handler *http) Pause(c *gin.Context) {
+	game, err := handler.gamePort.Pause(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponsePause(game))
+}
+
+func (handler *http) Resume(c *gin.Context) {
+	game, err := handler.gamePort.Resume(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseResume(game))
+}
+
+func (handler *http) Abandon(c *gin.Context) {
+	game, err := handler.gamePort.Abandon(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseAbandon(game))
+}
+
+func (handler *http) Replay(c *gin.Context) {
+	steps, err := handler.gamePort.Replay(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(500, gin.H{"message": err.Error()})
+		return
+	}
+
+	c.JSON(200, dto.BuildResponseReplay(steps))
+}
@@ -0,0 +1,22 @@
+package random
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+)
+
+// CryptoRandom draws seeds nobody can work out from the game, unlike its public id.
+type CryptoRandom struct{}
+
+func NewCryptoRandom() *CryptoRandom {
+	return &CryptoRandom{}
+}
+
+func (random *CryptoRandom) Seed() (int64, error) {
+	var bytes [8]byte
+	if _, err := rand.Read(bytes[:]); err != nil {
+		return 0, err
+	}
+
+	return int64(binary.BigEndian.Uint64(bytes[:])), nil
+}
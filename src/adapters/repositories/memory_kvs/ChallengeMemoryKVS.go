@@ -0,0 +1,44 @@
+package memory_kvs
+
+import (
+	"encoding/json"
+	"github.com/matiasvarela/errors"
+	"hexagonal/src/config/apperrors"
+	"hexagonal/src/config/messages"
+	"hexagonal/src/core/domain"
+)
+
+type ChallengeMemoryKVS struct {
+	kvs map[string][]byte
+}
+
+func NewChallengeMemKVS() *ChallengeMemoryKVS {
+	return &ChallengeMemoryKVS{
+		kvs: map[string][]byte{},
+	}
+}
+
+func (repo *ChallengeMemoryKVS) Get(id string) (domain.Challenge, error) {
+
+	if value, ok := repo.kvs[id]; ok {
+		challenge := domain.Challenge{}
+		err := json.Unmarshal(value, &challenge)
+		if err != nil {
+			return domain.Challenge{}, errors.New(apperrors.Internal, err, messages.ChallengeNotFoundFromKVS)
+		}
+
+		return challenge, nil
+	}
+
+	return domain.Challenge{}, errors.New(apperrors.NotFound, nil, "challenge not found in kvs")
+}
+
+func (repo *ChallengeMemoryKVS) Save(challenge domain.Challenge) error {
+	bytes, err := json.Marshal(challenge)
+	if err != nil {
+		return errors.New(apperrors.InvalidInput, err, messages.ChallengeMarshalingFailed)
+	}
+
+	repo.kvs[challenge.ID] = bytes
+	return nil
+}
@@ -6,8 +6,39 @@ const (
 	GameCannotBeCreatedFromRepository = "create game into repository has failed"
 	GameCannotBeUpdateFromRepository  = "update game into repository has failed"
 	GameBombsTooHigh                  = "the number of bombs is too high"
+	GameBombsMissing                  = "the number of bombs must be greater than zero"
+	GameRowsMissing                   = "the number of rows must be greater than zero"
+	GameColsMissing                   = "the number of columns must be greater than zero"
+	GameDifficultyUnknown             = "difficulty must be beginner, intermediate, expert or custom"
+	GameTopologyUnknown               = "topology must be square, hex or torus"
+	GameLivesTooHigh                  = "the number of lives cannot be higher than the number of bombs"
+	GameNoGuessNotPossible            = "a board without guessing cannot be generated with this number of bombs"
+	GameNoGuessTooLarge               = "a board without guessing can have at most 2500 cells"
+	GameMineOutOfBoard                = "mine is out of the board"
+	GameMineDuplicated                = "mine is listed more than once"
+	GameMinesWithNoGuess              = "mines cannot be listed for a board without guessing"
+	GameMinesWithDifficulty           = "mines can only be listed for a custom board"
+	GameSeedFailed                    = "draw a seed for the game has failed"
 	GameOver                          = "game is over"
+	GameIllegalTransition             = "the game does not allow this operation in its current state"
+	GamePaused                        = "game is paused"
+	GameNotOver                       = "game is not over yet"
 	GameInvalidPosition               = "invalid position"
+	GameCellFlagged                   = "cell is flagged"
+	GameCellRevealed                  = "cell is already revealed"
+	GameCellNotRevealed               = "cell is not revealed"
+	GameChordNotSatisfied             = "adjacent flags do not match the cell number"
+	GameNotPractice                   = "moves can only be undone in practice games"
+	GameUndoMissing                   = "the number of moves to undo must be greater than zero"
+	GameUndoTooHigh                   = "the number of moves to undo is higher than the moves played"
+	GameNoHint                        = "no cell can be proven safe"
+	GameNoHintUnflagged               = "every cell proven safe is flagged"
+	ChallengePlayerMissing            = "the player must be given"
+	ChallengeDayInvalid               = "the challenge day must be written as YYYY-MM-DD"
+	ChallengeFailedFromRepository     = "get challenge from repository has failed"
+	ChallengeCannotBeSaved            = "save challenge into repository has failed"
 	GameNotFoundFromKVS               = "fail to get value from kvs"
 	GameMarshalingFailed              = "game fails at marshal into json string"
+	ChallengeNotFoundFromKVS          = "fail to get challenge from kvs"
+	ChallengeMarshalingFailed         = "challenge fails at marshal into json string"
 )
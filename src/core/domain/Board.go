@@ -1,65 +1,151 @@
 package domain
 
 import (
+	"encoding/json"
 	"math/rand"
-	"time"
 )
 
-const (
-	CellBomb       = "X"
-	CellBombHidden = "-"
-	CellEmpty      = "-"
-	CellRevealed   = "0"
-)
-
-type Board [][]string
+// Board holds the cells of a game, laid out on a topology that tells which of them touch each other.
+type Board struct {
+	Cells    [][]Cell
+	Topology Topology
+}
 
-func NewBoard(size uint, bombs uint) Board {
-	board := NewEmptyBoard(size)
-	board.fillWithBombs(bombs)
+func NewBoard(rows uint, cols uint, bombs uint, random *rand.Rand) Board {
+	board := NewEmptyBoard(rows, cols)
+	board.fillWithBombs(bombs, random)
 
 	return board
 }
 
-func NewEmptyBoard(size uint) Board {
-	board := make([][]string, size)
+func NewEmptyBoard(rows uint, cols uint) Board {
+	cells := make([][]Cell, rows)
 
-	for row := range board {
-		board[row] = make([]string, size)
+	for row := range cells {
+		cells[row] = make([]Cell, cols)
 	}
 
-	for row := range board {
-		for col := range board[0] {
-			board[row][col] = CellEmpty
+	return Board{Cells: cells, Topology: SquareGrid{}}
+}
+
+// WithTopology returns the board laid out on the given topology, with its adjacency counts worked out again.
+func (board Board) WithTopology(topology Topology) Board {
+	newBoard := board.Copy()
+	newBoard.Topology = topology
+
+	for row := range newBoard.Cells {
+		for col := range newBoard.Cells[row] {
+			newBoard.Cells[row][col].Adjacent = 0
 		}
 	}
 
-	return board
+	for row := range newBoard.Cells {
+		for col := range newBoard.Cells[row] {
+			if newBoard.Cells[row][col].Mine {
+				for _, neighbour := range newBoard.Neighbours(uint(row), uint(col)) {
+					newBoard.Cells[neighbour.Row][neighbour.Col].Adjacent++
+				}
+			}
+		}
+	}
+
+	return newBoard
+}
+
+func (board Board) Rows() uint {
+	return uint(len(board.Cells))
 }
 
-func (board Board) fillWithBombs(bombs uint) {
+func (board Board) Cols() uint {
+	if len(board.Cells) == 0 {
+		return 0
+	}
+
+	return uint(len(board.Cells[0]))
+}
 
-	rows := len(board)
-	cols := len(board[0])
-	positions := _getRandomPositions(rows*cols, bombs)
+func (board Board) fillWithBombs(bombs uint, random *rand.Rand) {
+
+	rows := int(board.Rows())
+	cols := int(board.Cols())
+	positions := _getRandomPositions(random, rows*cols, bombs)
 
 	var row, col int
 	for _, pos := range positions {
 		row = pos / cols
-		col = pos - row*rows
-		board[row][col] = CellBomb
+		col = pos % cols
+		board.SetMine(uint(row), uint(col))
+	}
+}
+
+// PlaceBombs spreads the bombs over the board keeping the given cell clear.
+// Its neighbourhood is kept clear as well whenever the board has room for it,
+// so the first reveal always opens an area instead of a single number.
+// The random source decides the order in which cells are filled, so the same
+// source and dimensions always give the same layout outside the safe area.
+func (board Board) PlaceBombs(bombs uint, row uint, col uint, random *rand.Rand) {
+	rows, cols := int(board.Rows()), int(board.Cols())
+
+	safe := append(board.Neighbours(row, col), Position{Row: row, Col: col})
+	if uint(rows*cols-len(safe)) < bombs {
+		safe = []Position{{Row: row, Col: col}}
+	}
+
+	placed := uint(0)
+	for _, pos := range random.Perm(rows * cols) {
+		if placed == bombs {
+			break
+		}
+
+		r, c := uint(pos/cols), uint(pos%cols)
+		if _containsPosition(safe, r, c) {
+			continue
+		}
+
+		board.SetMine(r, c)
+		placed++
 	}
 }
 
+// HideBombs returns a copy of the board with only what the player is allowed to see:
+// unopened cells keep their marks but lose their bomb and adjacency information,
+// except for the bombs the player already set off.
 func (board Board) HideBombs() Board {
-	newBoard := NewEmptyBoard(uint(len(board)))
+	newBoard := board.empty()
 
-	for row := range board {
-		for col := range board[0] {
-			if board[row][col] == CellBomb {
-				newBoard[row][col] = CellBombHidden
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.Cells[row][col].Revealed || board.Cells[row][col].Exploded {
+				newBoard.Cells[row][col] = board.Cells[row][col]
 			} else {
-				newBoard[row][col] = board[row][col]
+				newBoard.Cells[row][col].Flagged = board.Cells[row][col].Flagged
+				newBoard.Cells[row][col].Questioned = board.Cells[row][col].Questioned
+			}
+		}
+	}
+
+	return newBoard
+}
+
+func (board Board) Copy() Board {
+	cells := make([][]Cell, len(board.Cells))
+
+	for row := range board.Cells {
+		cells[row] = make([]Cell, len(board.Cells[row]))
+		copy(cells[row], board.Cells[row])
+	}
+
+	return Board{Cells: cells, Topology: board.Topology}
+}
+
+// Layout returns a new board holding only the bombs of this one, as it was before any move.
+func (board Board) Layout() Board {
+	newBoard := board.empty()
+
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.Cells[row][col].Mine {
+				newBoard.SetMine(uint(row), uint(col))
 			}
 		}
 	}
@@ -68,21 +154,192 @@ func (board Board) HideBombs() Board {
 }
 
 func (board Board) IsValidPosition(row uint, col uint) bool {
-	return row < uint(len(board)) && col < uint(len(board[0]))
+	return board.topology().Contains(board.Rows(), board.Cols(), row, col)
 }
 
-func (board Board) Contains(row uint, col uint, element string) bool {
-	return board[row][col] == element
+func (board Board) Neighbours(row uint, col uint) []Position {
+	return board.topology().Neighbours(board.Rows(), board.Cols(), row, col)
 }
 
-func (board Board) Set(row uint, col uint, element string) {
-	board[row][col] = element
+// RowOffsets tells how far each row is shifted, in cells, when the board is drawn.
+// It is nil when no row is shifted, as on square boards.
+func (board Board) RowOffsets() []float64 {
+	offsets := make([]float64, board.Rows())
+	shifted := false
+
+	for row := range offsets {
+		offsets[row] = board.topology().RowOffset(uint(row))
+		shifted = shifted || offsets[row] != 0
+	}
+
+	if !shifted {
+		return nil
+	}
+
+	return offsets
+}
+
+func (board Board) IsMine(row uint, col uint) bool {
+	return board.Cells[row][col].Mine
+}
+
+// SetMine places a bomb in the cell and updates the adjacency count of its neighbours.
+func (board Board) SetMine(row uint, col uint) {
+	if board.Cells[row][col].Mine {
+		return
+	}
+
+	board.Cells[row][col].Mine = true
+
+	for _, neighbour := range board.Neighbours(row, col) {
+		board.Cells[neighbour.Row][neighbour.Col].Adjacent++
+	}
+}
+
+func (board Board) IsFlagged(row uint, col uint) bool {
+	return board.Cells[row][col].Flagged
+}
+
+func (board Board) IsQuestioned(row uint, col uint) bool {
+	return board.Cells[row][col].Questioned
+}
+
+func (board Board) IsRevealed(row uint, col uint) bool {
+	return board.Cells[row][col].Revealed
+}
+
+func (board Board) IsExploded(row uint, col uint) bool {
+	return board.Cells[row][col].Exploded
+}
+
+// ToggleFlag marks an unopened cell as a suspected bomb, or removes the mark if it is already flagged.
+// A question mark on the cell is replaced by the flag.
+func (board Board) ToggleFlag(row uint, col uint) {
+	if board.Cells[row][col].Revealed || board.Cells[row][col].Exploded {
+		return
+	}
+
+	board.Cells[row][col].Flagged = !board.Cells[row][col].Flagged
+	board.Cells[row][col].Questioned = false
 }
 
+// CycleMark moves the mark of an unopened cell from none to a flag, from a flag to a question mark
+// and from a question mark back to none.
+func (board Board) CycleMark(row uint, col uint) {
+	cell := &board.Cells[row][col]
+	if cell.Revealed || cell.Exploded {
+		return
+	}
+
+	switch {
+	case cell.Flagged:
+		cell.Flagged = false
+		cell.Questioned = true
+	case cell.Questioned:
+		cell.Questioned = false
+	default:
+		cell.Flagged = true
+	}
+}
+
+// CountFlags returns how many cells of the board are flagged. Question marks are not counted.
+func (board Board) CountFlags() uint {
+	count := uint(0)
+
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.Cells[row][col].Flagged {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+// Explode records a bomb set off by the player.
+func (board Board) Explode(row uint, col uint) {
+	board.Cells[row][col].Exploded = true
+}
+
+// Reveal opens the cell, dropping any question mark left on it.
+func (board Board) Reveal(row uint, col uint) {
+	board.Cells[row][col].Revealed = true
+	board.Cells[row][col].Questioned = false
+}
+
+// RevealArea reveals the given cell and, when it has no adjacent bombs, keeps
+// opening the connected region of empty cells together with its numbered border.
+// It walks the board with an explicit queue so large boards don't grow the stack.
+func (board Board) RevealArea(row uint, col uint) {
+	board.Reveal(row, col)
+	queue := []Position{{Row: row, Col: col}}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if board.CountAdjacentBombs(current.Row, current.Col) != 0 {
+			continue
+		}
+
+		for _, neighbour := range board.Neighbours(current.Row, current.Col) {
+			if board.isClosedSafeCell(neighbour.Row, neighbour.Col) && !board.IsFlagged(neighbour.Row, neighbour.Col) {
+				board.Reveal(neighbour.Row, neighbour.Col)
+				queue = append(queue, neighbour)
+			}
+		}
+	}
+}
+
+func (board Board) CountAdjacentBombs(row uint, col uint) uint {
+	return uint(board.Cells[row][col].Adjacent)
+}
+
+// CountAdjacentFlags counts the flagged neighbours of a cell. Bombs already set off
+// count as flagged, as their place is known.
+func (board Board) CountAdjacentFlags(row uint, col uint) uint {
+	count := uint(0)
+
+	for _, neighbour := range board.Neighbours(row, col) {
+		if board.IsFlagged(neighbour.Row, neighbour.Col) || board.IsExploded(neighbour.Row, neighbour.Col) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// HiddenNeighbours returns the adjacent cells that are neither revealed, flagged nor set off.
+func (board Board) HiddenNeighbours(row uint, col uint) []Position {
+	var positions []Position
+
+	for _, neighbour := range board.Neighbours(row, col) {
+		if !board.IsRevealed(neighbour.Row, neighbour.Col) && !board.IsFlagged(neighbour.Row, neighbour.Col) && !board.IsExploded(neighbour.Row, neighbour.Col) {
+			positions = append(positions, neighbour)
+		}
+	}
+
+	return positions
+}
+
+// ThreeBV returns the Bechtel's Board Benchmark Value of the bomb layout: the fewest clicks
+// needed to clear it, counting one for every opening of empty cells and one for every
+// numbered cell that no opening uncovers.
+func (board Board) ThreeBV() uint {
+	return board.Layout().clicksToClear()
+}
+
+// ThreeBVLeft returns the 3BV of the cells still closed, leaving out the ones already revealed.
+func (board Board) ThreeBVLeft() uint {
+	return board.Copy().clicksToClear()
+}
+
+// IsCellEmpty tells whether there is still a safe cell left to reveal.
 func (board Board) IsCellEmpty() bool {
-	for row := range board {
-		for col := range board[0] {
-			if board[row][col] == CellEmpty {
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.isClosedSafeCell(uint(row), uint(col)) {
 				return true
 			}
 		}
@@ -91,10 +348,146 @@ func (board Board) IsCellEmpty() bool {
 	return false
 }
 
+// MarshalJSON stores the cells alone, each packed into a number so large boards stay small;
+// the topology is kept in the board settings.
+func (board Board) MarshalJSON() ([]byte, error) {
+	codes := make([][]uint16, len(board.Cells))
+
+	for row := range board.Cells {
+		codes[row] = make([]uint16, len(board.Cells[row]))
+
+		for col, cell := range board.Cells[row] {
+			codes[row][col] = cell.code()
+		}
+	}
+
+	return json.Marshal(codes)
+}
+
+// UnmarshalJSON keeps the stored adjacency counts. It also reads the cells stored as objects
+// and, before the typed cell model, as strings. Those did not carry the counts, so they are
+// worked out again; such boards were always square.
+// Boards are loaded square; games lay them out on their own topology afterwards.
+func (board *Board) UnmarshalJSON(data []byte) error {
+	switch _firstCellByte(data) {
+	case '"':
+		var cells [][]Cell
+		if err := json.Unmarshal(data, &cells); err != nil {
+			return err
+		}
+
+		*board = Board{Cells: cells}.WithTopology(SquareGrid{})
+	case '{':
+		var cells [][]Cell
+		if err := json.Unmarshal(data, &cells); err != nil {
+			return err
+		}
+
+		*board = Board{Cells: cells, Topology: SquareGrid{}}
+	default:
+		var codes [][]uint16
+		if err := json.Unmarshal(data, &codes); err != nil {
+			return err
+		}
+
+		cells := make([][]Cell, len(codes))
+		for row := range codes {
+			cells[row] = make([]Cell, len(codes[row]))
+
+			for col, code := range codes[row] {
+				cells[row][col] = _cellFromCode(code)
+			}
+		}
+
+		*board = Board{Cells: cells, Topology: SquareGrid{}}
+	}
+
+	return nil
+}
+
 // ··· Private Functions ··· //
-func _getRandomPositions(size int, n uint) []int {
-	rand.Seed(time.Now().UnixNano())
-	p := rand.Perm(size)
+func (board Board) topology() Topology {
+	if board.Topology == nil {
+		return SquareGrid{}
+	}
+
+	return board.Topology
+}
+
+// empty returns a board of the same dimensions and topology with no bombs.
+func (board Board) empty() Board {
+	newBoard := NewEmptyBoard(board.Rows(), board.Cols())
+	newBoard.Topology = board.Topology
+
+	return newBoard
+}
+
+// clicksToClear opens the board the fewest clicks it takes and counts them.
+func (board Board) clicksToClear() uint {
+	count := uint(0)
+
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.isClosedSafeCell(uint(row), uint(col)) && board.CountAdjacentBombs(uint(row), uint(col)) == 0 {
+				board.RevealArea(uint(row), uint(col))
+				count++
+			}
+		}
+	}
+
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.isClosedSafeCell(uint(row), uint(col)) {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func (board Board) hasMines() bool {
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.Cells[row][col].Mine {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (board Board) isClosedSafeCell(row uint, col uint) bool {
+	return !board.Cells[row][col].Mine && !board.Cells[row][col].Revealed
+}
+
+// _firstCellByte returns the byte the first stored cell starts with, which tells how cells were stored.
+func _firstCellByte(data []byte) byte {
+	for _, b := range data {
+		switch b {
+		case '[', ' ', '\t', '\r', '\n':
+			continue
+		}
+
+		return b
+	}
+
+	return 0
+}
+
+func _containsPosition(positions []Position, row uint, col uint) bool {
+	for _, pos := range positions {
+		if pos.Row == row && pos.Col == col {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _getRandomPositions(random *rand.Rand, size int, n uint) []int {
+	p := random.Perm(size)
 
 	var positions []int
 
@@ -1,6 +1,92 @@
 package domain
 
+import "encoding/json"
+
+const (
+	DifficultyBeginner     = "beginner"
+	DifficultyIntermediate = "intermediate"
+	DifficultyExpert       = "expert"
+	DifficultyCustom       = "custom"
+)
+
+var difficultyPresets = map[string]BoardSettings{
+	DifficultyBeginner:     {Rows: 9, Cols: 9, Bombs: 10},
+	DifficultyIntermediate: {Rows: 16, Cols: 16, Bombs: 40},
+	DifficultyExpert:       {Rows: 16, Cols: 30, Bombs: 99},
+}
+
 type BoardSettings struct {
-	Size  uint `json:"size"`
-	Bombs uint `json:"bombs"`
+	Rows       uint       `json:"rows"`
+	Cols       uint       `json:"cols"`
+	Bombs      uint       `json:"bombs"`
+	Seed       *int64     `json:"seed,omitempty"`
+	NoGuess    bool       `json:"no_guess,omitempty"`
+	Difficulty string     `json:"difficulty,omitempty"`
+	Start      *Position  `json:"start,omitempty"`
+	Practice   bool       `json:"practice,omitempty"`
+	Topology   string     `json:"topology,omitempty"`
+	Lives      uint       `json:"lives,omitempty"`
+	MaskPaused bool       `json:"mask_paused,omitempty"`
+	Mines      []Position `json:"mines,omitempty"`
+}
+
+// WithDifficulty returns the settings with the dimensions and bombs of their difficulty preset.
+// Settings without a difficulty are custom and keep their own values; unknown difficulties are reported.
+func (settings BoardSettings) WithDifficulty() (BoardSettings, bool) {
+	if settings.Difficulty == "" || settings.Difficulty == DifficultyCustom {
+		settings.Difficulty = DifficultyCustom
+		return settings, true
+	}
+
+	preset, ok := difficultyPresets[settings.Difficulty]
+	if !ok {
+		return settings, false
+	}
+
+	settings.Rows = preset.Rows
+	settings.Cols = preset.Cols
+	settings.Bombs = preset.Bombs
+
+	return settings, true
+}
+
+// EmptyBoard returns a board with the dimensions and topology of the settings and no bombs.
+func (settings BoardSettings) EmptyBoard() Board {
+	topology, _ := TopologyOf(settings.Topology)
+
+	return NewEmptyBoard(settings.Rows, settings.Cols).WithTopology(topology)
+}
+
+// MinedBoard returns the empty board of the settings with their listed mines laid on it.
+func (settings BoardSettings) MinedBoard() Board {
+	board := settings.EmptyBoard()
+	for _, mine := range settings.Mines {
+		board.SetMine(mine.Row, mine.Col)
+	}
+
+	return board
+}
+
+// UnmarshalJSON also accepts the settings stored before boards could be rectangular,
+// which only carried a single "size" for both dimensions.
+func (settings *BoardSettings) UnmarshalJSON(data []byte) error {
+	type boardSettings BoardSettings
+
+	stored := struct {
+		boardSettings
+		Size uint `json:"size"`
+	}{}
+
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+
+	*settings = BoardSettings(stored.boardSettings)
+
+	if settings.Rows == 0 && settings.Cols == 0 {
+		settings.Rows = stored.Size
+		settings.Cols = stored.Size
+	}
+
+	return nil
 }
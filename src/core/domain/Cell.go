@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Cell is a board square. Flagged and Questioned are the player's marks: a flag claims a bomb,
+// while a question mark is only a reminder and never counts as one.
+type Cell struct {
+	Mine       bool  `json:"mine"`
+	Revealed   bool  `json:"revealed"`
+	Flagged    bool  `json:"flagged"`
+	Questioned bool  `json:"questioned"`
+	Exploded   bool  `json:"exploded"`
+	Adjacent   uint8 `json:"adjacent"`
+}
+
+// Stored cells are packed into a number: the adjacent bombs count in the low bits, then a bit for each flag.
+const (
+	cellAdjacentMask uint16 = 0x0f
+	cellMine         uint16 = 1 << (iota + 3)
+	cellRevealed
+	cellFlagged
+	cellQuestioned
+	cellExploded
+)
+
+// UnmarshalJSON also accepts the string cells stored before the typed cell model.
+func (cell *Cell) UnmarshalJSON(data []byte) error {
+	var legacy string
+	if err := json.Unmarshal(data, &legacy); err == nil {
+		*cell = _legacyCell(legacy)
+		return nil
+	}
+
+	type cellFields Cell
+
+	return json.Unmarshal(data, (*cellFields)(cell))
+}
+
+// ··· Private Functions ··· //
+func (cell Cell) code() uint16 {
+	code := uint16(cell.Adjacent) & cellAdjacentMask
+
+	for _, flag := range []struct {
+		set  bool
+		code uint16
+	}{
+		{cell.Mine, cellMine},
+		{cell.Revealed, cellRevealed},
+		{cell.Flagged, cellFlagged},
+		{cell.Questioned, cellQuestioned},
+		{cell.Exploded, cellExploded},
+	} {
+		if flag.set {
+			code |= flag.code
+		}
+	}
+
+	return code
+}
+
+func _cellFromCode(code uint16) Cell {
+	return Cell{
+		Mine:       code&cellMine != 0,
+		Revealed:   code&cellRevealed != 0,
+		Flagged:    code&cellFlagged != 0,
+		Questioned: code&cellQuestioned != 0,
+		Exploded:   code&cellExploded != 0,
+		Adjacent:   uint8(code & cellAdjacentMask),
+	}
+}
+
+func _legacyCell(value string) Cell {
+	switch value {
+	case "X":
+		return Cell{Mine: true}
+	case "FX":
+		return Cell{Mine: true, Flagged: true}
+	case "F":
+		return Cell{Flagged: true}
+	case "-":
+		return Cell{}
+	}
+
+	if adjacent, err := strconv.Atoi(value); err == nil {
+		return Cell{Revealed: true, Adjacent: uint8(adjacent)}
+	}
+
+	return Cell{}
+}
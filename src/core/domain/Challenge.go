@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+// ChallengeDayLayout is how challenge IDs write their calendar day.
+const ChallengeDayLayout = "2006-01-02"
+
+// Challenge is the daily board shared by every player, and the games played on it.
+type Challenge struct {
+	ID      string           `json:"id"`
+	Entries []ChallengeEntry `json:"entries,omitempty"`
+}
+
+// ChallengeEntry is the game a player got for a challenge.
+type ChallengeEntry struct {
+	Player string `json:"player"`
+	GameID string `json:"game_id"`
+}
+
+// ChallengeResult is how a player is doing on a challenge.
+type ChallengeResult struct {
+	Player    string
+	GameID    string
+	State     string
+	Elapsed   time.Duration
+	Assisted  bool
+	MultiLife bool
+	Score     *Score
+}
+
+// ChallengeID returns the ID of the challenge for the calendar day, in UTC, of the given time.
+func ChallengeID(now time.Time) string {
+	return now.UTC().Format(ChallengeDayLayout)
+}
+
+// ChallengeSeed derives the seed of a challenge board from the server secret,
+// so nobody can work the board out from its date alone.
+func ChallengeSeed(secret string, id string) int64 {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(id))
+
+	return int64(binary.BigEndian.Uint64(mac.Sum(nil)))
+}
+
+// GameOf returns the game the player already got for the challenge.
+func (challenge Challenge) GameOf(player string) (string, bool) {
+	for _, entry := range challenge.Entries {
+		if entry.Player == player {
+			return entry.GameID, true
+		}
+	}
+
+	return "", false
+}
@@ -1,9 +1,9 @@
 package domain
 
-const (
-	GameStateWon  = "won"
-	GameStateLost = "lost"
-	GameStateNew  = "new"
+import (
+	"encoding/json"
+	"math/rand"
+	"time"
 )
 
 type Game struct {
@@ -12,21 +12,232 @@ type Game struct {
 	State         string        `json:"state"`
 	BoardSettings BoardSettings `json:"board_settings"`
 	Board         Board         `json:"board"`
+	Seeded        bool          `json:"seeded"`
+	StartedAt     *time.Time    `json:"started_at,omitempty"`
+	FinishedAt    *time.Time    `json:"finished_at,omitempty"`
+	Elapsed       time.Duration `json:"-"`
+	Moves         []Move        `json:"moves,omitempty"`
+	Assisted      bool          `json:"assisted,omitempty"`
+	Detonations   []Position    `json:"detonations,omitempty"`
+	ThreeBV       uint          `json:"3bv,omitempty"`
+	Score         *Score        `json:"score,omitempty"`
+	Pauses        []Pause       `json:"pauses,omitempty"`
+	Challenge     string        `json:"challenge,omitempty"`
 }
 
-func NewGame(id string, name string, size uint, bombs uint) Game {
+// NewGame creates a game whose bombs are placed on the first reveal, from the seed of its settings.
+func NewGame(id string, name string, settings BoardSettings) Game {
 	return Game{
-		ID:    id,
-		Name:  name,
-		State: GameStateNew,
-		BoardSettings: BoardSettings{
-			Size:  size,
-			Bombs: bombs,
-		},
-		Board: NewBoard(size, bombs),
+		ID:            id,
+		Name:          name,
+		State:         GameStateNew,
+		BoardSettings: settings,
+		Board:         settings.EmptyBoard(),
 	}
 }
 
+// Seed places the bombs once the first cell to reveal is known, so that cell is never a bomb.
+func (game *Game) Seed(row uint, col uint) {
+	game.Board.PlaceBombs(game.BoardSettings.Bombs, row, col, game.random())
+	game.SetLayout(game.Board)
+}
+
+// SetLayout plays the game on a board whose bombs are already placed, and works out its 3BV.
+func (game *Game) SetLayout(board Board) {
+	game.Board = board
+	game.Seeded = true
+	game.ThreeBV = board.ThreeBV()
+}
+
+// OpenStart opens the start cell for the player before the first move. The 3BV leaves its opening out,
+// as the player never clicks it.
+func (game *Game) OpenStart(start Position) {
+	game.BoardSettings.Start = &start
+	game.Board.RevealArea(start.Row, start.Col)
+	game.ThreeBV = game.Board.ThreeBVLeft()
+}
+
+// Restart returns the game as it was before its first move, keeping its bomb layout
+// and the cell opened for the player when the board was generated.
+func (game *Game) Restart() Game {
+	board := game.Board.Layout()
+	if game.BoardSettings.Start != nil {
+		board.RevealArea(game.BoardSettings.Start.Row, game.BoardSettings.Start.Col)
+	}
+
+	return Game{
+		ID:            game.ID,
+		Name:          game.Name,
+		State:         GameStateNew,
+		BoardSettings: game.BoardSettings,
+		Board:         board,
+		Seeded:        game.Seeded,
+		ThreeBV:       game.ThreeBV,
+		Challenge:     game.Challenge,
+	}
+}
+
+// TrackTime records the time of the first move and, once the game is over, the time it ended.
+func (game *Game) TrackTime(now time.Time) {
+	if game.StartedAt == nil {
+		game.StartedAt = &now
+	}
+
+	if game.IsOver() && game.FinishedAt == nil {
+		game.FinishedAt = &now
+	}
+}
+
+// ScoreWin rates a won game from its 3BV, the moves played and the time it took.
+func (game *Game) ScoreWin() {
+	score := Score{
+		ThreeBV: game.ThreeBV,
+		Clicks:  uint(len(game.Moves)),
+	}
+
+	if score.Clicks > 0 {
+		score.Efficiency = float64(score.ThreeBV) / float64(score.Clicks)
+	}
+
+	if game.FinishedAt != nil {
+		if seconds := game.ElapsedAt(*game.FinishedAt).Seconds(); seconds > 0 {
+			score.ThreeBVPerSecond = float64(score.ThreeBV) / seconds
+		}
+	}
+
+	game.Score = &score
+}
+
+// PauseAt stops the clock of the game.
+func (game *Game) PauseAt(now time.Time) {
+	game.Pauses = append(game.Pauses, Pause{PausedAt: now})
+}
+
+// ResumeAt starts the clock of the game again.
+func (game *Game) ResumeAt(now time.Time) {
+	if len(game.Pauses) > 0 {
+		game.Pauses[len(game.Pauses)-1].ResumedAt = &now
+	}
+}
+
+// ElapsedAt returns the time played so far, or the total time played once the game is over.
+// The time the game spent paused is left out.
+func (game *Game) ElapsedAt(now time.Time) time.Duration {
+	if game.StartedAt == nil {
+		return 0
+	}
+
+	end := now
+	if game.FinishedAt != nil {
+		end = *game.FinishedAt
+	}
+
+	elapsed := end.Sub(*game.StartedAt)
+
+	for _, pause := range game.Pauses {
+		resumed := end
+		if pause.ResumedAt != nil && pause.ResumedAt.Before(end) {
+			resumed = *pause.ResumedAt
+		}
+
+		if resumed.After(pause.PausedAt) {
+			elapsed -= resumed.Sub(pause.PausedAt)
+		}
+	}
+
+	return elapsed
+}
+
+// VisibleBoard returns what the player may see: the board with its bombs hidden
+// while the game is being played, and the whole board once it is over.
+// Paused games whose settings ask for it show no cell at all, so the board cannot be studied meanwhile.
+func (game *Game) VisibleBoard() Board {
+	if game.IsOver() {
+		return game.Board.Copy()
+	}
+
+	if game.State == GameStatePaused && game.BoardSettings.MaskPaused {
+		return game.BoardSettings.EmptyBoard()
+	}
+
+	return game.Board.HideBombs()
+}
+
+// LivesLeft returns how many more bombs the player can set off before losing.
+// Games are played with a single life unless their settings give more.
+func (game *Game) LivesLeft() uint {
+	lives := game.BoardSettings.Lives
+	if lives == 0 {
+		lives = 1
+	}
+
+	if uint(len(game.Detonations)) >= lives {
+		return 0
+	}
+
+	return lives - uint(len(game.Detonations))
+}
+
+// MultiLife tells whether the game can survive setting a bomb off.
+func (game *Game) MultiLife() bool {
+	return game.BoardSettings.Lives > 1
+}
+
+// Detonate sets off the bomb of a cell, costing a life. The game is lost once no lives are left.
+func (game *Game) Detonate(row uint, col uint) {
+	game.Board.Explode(row, col)
+	game.Detonations = append(game.Detonations, Position{Row: row, Col: col})
+
+	if game.LivesLeft() == 0 {
+		game.Transition(GameEventLose)
+	}
+}
+
+// MinesLeft returns the bombs the player has not accounted for yet, that is the bombs
+// neither flagged nor set off. It goes below zero when there are more flags than bombs.
+func (game *Game) MinesLeft() int {
+	return int(game.BoardSettings.Bombs) - int(game.Board.CountFlags()) - len(game.Detonations)
+}
+
 func (game *Game) IsOver() bool {
-	return game.State == GameStateLost || game.State == GameStateWon
+	return game.State == GameStateLost || game.State == GameStateWon || game.State == GameStateAbandoned
+}
+
+// UnmarshalJSON lays the stored board out on the topology of the game, keeping the adjacency counts
+// it was stored with.
+// Games stored before they had an in progress state were still new after their first move,
+// and games stored before bombs were placed on the first reveal got them when they were created.
+func (game *Game) UnmarshalJSON(data []byte) error {
+	type stored Game
+
+	if err := json.Unmarshal(data, (*stored)(game)); err != nil {
+		return err
+	}
+
+	if game.State == GameStateNew && game.StartedAt != nil {
+		game.State = GameStateInProgress
+	}
+
+	topology, _ := TopologyOf(game.BoardSettings.Topology)
+	game.Board.Topology = topology
+
+	if !game.Seeded && game.Board.hasMines() {
+		game.Seeded = true
+		game.ThreeBV = game.Board.ThreeBV()
+	}
+
+	return nil
+}
+
+// ··· Private Functions ··· //
+
+// random lays the bombs out from the seed of the game. The use cases draw one for every game
+// before its first reveal, so only games built by hand are laid out from the zero seed.
+func (game *Game) random() *rand.Rand {
+	seed := int64(0)
+	if game.BoardSettings.Seed != nil {
+		seed = *game.BoardSettings.Seed
+	}
+
+	return rand.New(rand.NewSource(seed))
 }
@@ -0,0 +1,80 @@
+package domain
+
+const (
+	GameStateNew        = "new"
+	GameStateInProgress = "in_progress"
+	GameStatePaused     = "paused"
+	GameStateWon        = "won"
+	GameStateLost       = "lost"
+	GameStateAbandoned  = "abandoned"
+)
+
+// Events are what can happen to a game. Each one is only allowed in some states,
+// and moves the game to the state given by gameTransitions.
+const (
+	GameEventPlay    = "play"
+	GameEventWin     = "win"
+	GameEventLose    = "lose"
+	GameEventAssist  = "assist"
+	GameEventPause   = "pause"
+	GameEventResume  = "resume"
+	GameEventRewind  = "rewind"
+	GameEventReview  = "review"
+	GameEventAbandon = "abandon"
+)
+
+var gameTransitions = map[string]map[string]string{
+	GameEventPlay: {
+		GameStateNew:        GameStateInProgress,
+		GameStateInProgress: GameStateInProgress,
+	},
+	GameEventWin: {
+		GameStateInProgress: GameStateWon,
+	},
+	GameEventLose: {
+		GameStateInProgress: GameStateLost,
+	},
+	GameEventAssist: {
+		GameStateNew:        GameStateNew,
+		GameStateInProgress: GameStateInProgress,
+	},
+	GameEventPause: {
+		GameStateInProgress: GameStatePaused,
+	},
+	GameEventResume: {
+		GameStatePaused: GameStateInProgress,
+	},
+	GameEventRewind: {
+		GameStateInProgress: GameStateNew,
+		GameStateWon:        GameStateNew,
+		GameStateLost:       GameStateNew,
+	},
+	GameEventReview: {
+		GameStateWon:       GameStateWon,
+		GameStateLost:      GameStateLost,
+		GameStateAbandoned: GameStateAbandoned,
+	},
+	GameEventAbandon: {
+		GameStateNew:        GameStateAbandoned,
+		GameStateInProgress: GameStateAbandoned,
+		GameStatePaused:     GameStateAbandoned,
+	},
+}
+
+// Can tells whether the event is allowed in the current state of the game.
+func (game *Game) Can(event string) bool {
+	_, ok := gameTransitions[event][game.State]
+
+	return ok
+}
+
+// Transition moves the game to the state the event leads to.
+// It tells whether the event was allowed, leaving the game untouched when it was not.
+func (game *Game) Transition(event string) bool {
+	state, ok := gameTransitions[event][game.State]
+	if ok {
+		game.State = state
+	}
+
+	return ok
+}
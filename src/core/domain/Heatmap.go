@@ -0,0 +1,15 @@
+package domain
+
+// Probability is the chance of an unrevealed cell holding a bomb.
+type Probability struct {
+	Row  uint    `json:"row"`
+	Col  uint    `json:"col"`
+	Mine float64 `json:"mine"`
+}
+
+// Heatmap holds the bomb probability of every unrevealed cell.
+// Exact is false when some of them had to be approximated.
+type Heatmap struct {
+	Cells []Probability `json:"cells"`
+	Exact bool          `json:"exact"`
+}
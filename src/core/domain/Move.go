@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+const (
+	MoveReveal = "reveal"
+	MoveFlag   = "flag"
+	MoveChord  = "chord"
+	MoveMark   = "mark"
+)
+
+const (
+	MoveOutcomeOpened     = "opened"
+	MoveOutcomeExploded   = "exploded"
+	MoveOutcomeFlagged    = "flagged"
+	MoveOutcomeUnflagged  = "unflagged"
+	MoveOutcomeQuestioned = "questioned"
+	MoveOutcomeUnmarked   = "unmarked"
+)
+
+// Move is an action played on a game, as kept in its history.
+// State is the state the game was left in after the move.
+type Move struct {
+	Action  string    `json:"action"`
+	Row     uint      `json:"row"`
+	Col     uint      `json:"col"`
+	At      time.Time `json:"at"`
+	Outcome string    `json:"outcome"`
+	State   string    `json:"state"`
+}
+
+// ReplayStep is the game as it was right after one of its moves.
+type ReplayStep struct {
+	Move  Move   `json:"move"`
+	State string `json:"state"`
+	Board Board  `json:"board"`
+}
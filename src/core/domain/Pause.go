@@ -0,0 +1,9 @@
+package domain
+
+import "time"
+
+// Pause is a time the clock of a game was stopped. ResumedAt is nil while the game is still paused.
+type Pause struct {
+	PausedAt  time.Time  `json:"paused_at"`
+	ResumedAt *time.Time `json:"resumed_at,omitempty"`
+}
@@ -0,0 +1,6 @@
+package domain
+
+type Position struct {
+	Row uint `json:"row"`
+	Col uint `json:"col"`
+}
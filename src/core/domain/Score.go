@@ -0,0 +1,10 @@
+package domain
+
+// Score rates how a game was won. Efficiency is the 3BV over the clicks used,
+// and ThreeBVPerSecond the 3BV over the seconds played.
+type Score struct {
+	ThreeBV          uint    `json:"3bv"`
+	Clicks           uint    `json:"clicks"`
+	Efficiency       float64 `json:"efficiency"`
+	ThreeBVPerSecond float64 `json:"3bv_per_second"`
+}
@@ -0,0 +1,145 @@
+package domain
+
+const (
+	TopologySquare = "square"
+	TopologyHex    = "hex"
+	TopologyTorus  = "torus"
+)
+
+// Topology tells how the cells of a board are laid out: which positions exist, which cells touch each other
+// and how far each row is shifted, in cells, when the board is drawn.
+type Topology interface {
+	Name() string
+	Contains(rows uint, cols uint, row uint, col uint) bool
+	Neighbours(rows uint, cols uint, row uint, col uint) []Position
+	RowOffset(row uint) float64
+}
+
+// TopologyOf returns the topology with the given name. Boards without one are square.
+func TopologyOf(name string) (Topology, bool) {
+	switch name {
+	case "", TopologySquare:
+		return SquareGrid{}, true
+	case TopologyHex:
+		return HexGrid{}, true
+	case TopologyTorus:
+		return TorusGrid{}, true
+	}
+
+	return SquareGrid{}, false
+}
+
+// SquareGrid is the classic board, where every cell touches the 8 cells around it.
+type SquareGrid struct{}
+
+func (SquareGrid) Name() string {
+	return TopologySquare
+}
+
+func (SquareGrid) Contains(rows uint, cols uint, row uint, col uint) bool {
+	return row < rows && col < cols
+}
+
+func (SquareGrid) RowOffset(row uint) float64 {
+	return 0
+}
+
+func (grid SquareGrid) Neighbours(rows uint, cols uint, row uint, col uint) []Position {
+	var positions []Position
+
+	for dRow := -1; dRow <= 1; dRow++ {
+		for dCol := -1; dCol <= 1; dCol++ {
+			if dRow == 0 && dCol == 0 {
+				continue
+			}
+
+			positions = _appendContained(positions, grid, rows, cols, int(row)+dRow, int(col)+dCol)
+		}
+	}
+
+	return positions
+}
+
+// HexGrid lays out hexagons in rows, with odd rows shifted half a cell to the right,
+// so every cell touches 6 others: two in its own row and two in each of the rows around it.
+type HexGrid struct{}
+
+func (HexGrid) Name() string {
+	return TopologyHex
+}
+
+func (HexGrid) Contains(rows uint, cols uint, row uint, col uint) bool {
+	return row < rows && col < cols
+}
+
+func (HexGrid) RowOffset(row uint) float64 {
+	if row%2 == 1 {
+		return 0.5
+	}
+
+	return 0
+}
+
+func (grid HexGrid) Neighbours(rows uint, cols uint, row uint, col uint) []Position {
+	shift := 0
+	if row%2 == 1 {
+		shift = 1
+	}
+
+	r, c := int(row), int(col)
+	var positions []Position
+
+	positions = _appendContained(positions, grid, rows, cols, r-1, c-1+shift)
+	positions = _appendContained(positions, grid, rows, cols, r-1, c+shift)
+	positions = _appendContained(positions, grid, rows, cols, r, c-1)
+	positions = _appendContained(positions, grid, rows, cols, r, c+1)
+	positions = _appendContained(positions, grid, rows, cols, r+1, c-1+shift)
+	positions = _appendContained(positions, grid, rows, cols, r+1, c+shift)
+
+	return positions
+}
+
+// TorusGrid is a square board whose edges wrap around, so cells on opposite edges touch each other
+// and every cell, corners included, has 8 neighbours. Boards narrower than 3 cells have fewer,
+// as the same cell is not counted twice.
+type TorusGrid struct{}
+
+func (TorusGrid) Name() string {
+	return TopologyTorus
+}
+
+func (TorusGrid) Contains(rows uint, cols uint, row uint, col uint) bool {
+	return row < rows && col < cols
+}
+
+func (TorusGrid) RowOffset(row uint) float64 {
+	return 0
+}
+
+func (TorusGrid) Neighbours(rows uint, cols uint, row uint, col uint) []Position {
+	var positions []Position
+
+	for dRow := -1; dRow <= 1; dRow++ {
+		for dCol := -1; dCol <= 1; dCol++ {
+			r := uint((int(row) + dRow + int(rows)) % int(rows))
+			c := uint((int(col) + dCol + int(cols)) % int(cols))
+
+			if (r == row && c == col) || _containsPosition(positions, r, c) {
+				continue
+			}
+
+			positions = append(positions, Position{Row: r, Col: c})
+		}
+	}
+
+	return positions
+}
+
+// ··· Private Functions ··· //
+func _appendContained(positions []Position, topology Topology, rows uint, cols uint, row int, col int) []Position {
+	if row < 0 || col < 0 || !topology.Contains(rows, cols, uint(row), uint(col)) {
+		return positions
+	}
+
+	return append(positions, Position{Row: uint(row), Col: uint(col)})
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type ResponseAbandon ResponseGame
+
+func BuildResponseAbandon(model domain.Game) ResponseAbandon {
+	return ResponseAbandon(BuildResponseGame(model))
+}
@@ -0,0 +1,14 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type BodyChordCell struct {
+	Row uint `json:"row"`
+	Col uint `json:"col"`
+}
+
+type ResponseChordCell ResponseGame
+
+func BuildResponseChordCell(model domain.Game) ResponseChordCell {
+	return ResponseChordCell(BuildResponseGame(model))
+}
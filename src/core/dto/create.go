@@ -3,13 +3,48 @@ package dto
 import "hexagonal/src/core/domain"
 
 type BodyCreate struct {
-	Name  string `json:"name"`
-	Size  uint   `json:"size"`
-	Bombs uint   `json:"bombs"`
+	Name       string            `json:"name"`
+	Rows       uint              `json:"rows"`
+	Cols       uint              `json:"cols"`
+	Size       uint              `json:"size"`
+	Bombs      uint              `json:"bombs"`
+	Seed       *int64            `json:"seed"`
+	NoGuess    bool              `json:"no_guess"`
+	Difficulty string            `json:"difficulty"`
+	Practice   bool              `json:"practice"`
+	Topology   string            `json:"topology"`
+	Lives      uint              `json:"lives"`
+	MaskPaused bool              `json:"mask_paused"`
+	Mines      []domain.Position `json:"mines"`
 }
 
-type ResponseCreate domain.Game
+// Settings returns the board requested, falling back to a square board of "size"
+// when no rows and columns are given. Listed mines replace the random placement.
+func (body BodyCreate) Settings() domain.BoardSettings {
+	settings := domain.BoardSettings{
+		Rows:       body.Rows,
+		Cols:       body.Cols,
+		Bombs:      body.Bombs,
+		Seed:       body.Seed,
+		NoGuess:    body.NoGuess,
+		Difficulty: body.Difficulty,
+		Practice:   body.Practice,
+		Topology:   body.Topology,
+		Lives:      body.Lives,
+		MaskPaused: body.MaskPaused,
+		Mines:      body.Mines,
+	}
+
+	if body.Rows == 0 && body.Cols == 0 {
+		settings.Rows = body.Size
+		settings.Cols = body.Size
+	}
+
+	return settings
+}
+
+type ResponseCreate ResponseGame
 
 func BuildResponseCreate(model domain.Game) ResponseCreate {
-	return ResponseCreate(model)
+	return ResponseCreate(BuildResponseGame(model))
 }
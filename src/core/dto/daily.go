@@ -0,0 +1,46 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type BodyDaily struct {
+	Player string `json:"player"`
+}
+
+type ResponseDaily ResponseGame
+
+func BuildResponseDaily(model domain.Game) ResponseDaily {
+	return ResponseDaily(BuildResponseGame(model))
+}
+
+type ResponseDailyResult struct {
+	Player    string        `json:"player"`
+	GameID    string        `json:"game_id,omitempty"`
+	State     string        `json:"state"`
+	Elapsed   float64       `json:"elapsed_seconds"`
+	Assisted  bool          `json:"assisted"`
+	MultiLife bool          `json:"multi_life"`
+	Score     *domain.Score `json:"score,omitempty"`
+}
+
+type ResponseDailyResults struct {
+	Challenge string                `json:"challenge"`
+	Results   []ResponseDailyResult `json:"results"`
+}
+
+func BuildResponseDailyResults(challenge string, results []domain.ChallengeResult) ResponseDailyResults {
+	response := ResponseDailyResults{Challenge: challenge, Results: make([]ResponseDailyResult, 0, len(results))}
+
+	for _, result := range results {
+		response.Results = append(response.Results, ResponseDailyResult{
+			Player:    result.Player,
+			GameID:    result.GameID,
+			State:     result.State,
+			Elapsed:   result.Elapsed.Seconds(),
+			Assisted:  result.Assisted,
+			MultiLife: result.MultiLife,
+			Score:     result.Score,
+		})
+	}
+
+	return response
+}
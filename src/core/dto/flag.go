@@ -0,0 +1,14 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type BodyFlagCell struct {
+	Row uint `json:"row"`
+	Col uint `json:"col"`
+}
+
+type ResponseFlagCell ResponseGame
+
+func BuildResponseFlagCell(model domain.Game) ResponseFlagCell {
+	return ResponseFlagCell(BuildResponseGame(model))
+}
@@ -0,0 +1,164 @@
+package dto
+
+import (
+	"hexagonal/src/core/domain"
+	"strconv"
+	"time"
+)
+
+const (
+	CellHidden        = "-"
+	CellFlagged       = "F"
+	CellQuestioned    = "?"
+	CellMine          = "X"
+	CellMineExploded  = "*"
+	CellMineFlagged   = "F"
+	CellFlagMisplaced = "!"
+)
+
+type ResponseGame struct {
+	ID            string                `json:"id"`
+	Name          string                `json:"name"`
+	State         string                `json:"state"`
+	BoardSettings ResponseBoardSettings `json:"board_settings"`
+	Board         [][]string            `json:"board"`
+	RowOffsets    []float64             `json:"row_offsets,omitempty"`
+	Seeded        bool                  `json:"seeded"`
+	StartedAt     *time.Time            `json:"started_at,omitempty"`
+	FinishedAt    *time.Time            `json:"finished_at,omitempty"`
+	Elapsed       float64               `json:"elapsed_seconds"`
+	Assisted      bool                  `json:"assisted"`
+	MultiLife     bool                  `json:"multi_life"`
+	Lives         uint                  `json:"lives"`
+	MinesLeft     int                   `json:"mines_left"`
+	ThreeBV       uint                  `json:"3bv,omitempty"`
+	Score         *domain.Score         `json:"score,omitempty"`
+	Pauses        []domain.Pause        `json:"pauses,omitempty"`
+	Detonations   []domain.Position     `json:"detonations,omitempty"`
+	Challenge     string                `json:"challenge,omitempty"`
+}
+
+func BuildResponseGame(model domain.Game) ResponseGame {
+	return ResponseGame{
+		ID:            model.ID,
+		Name:          model.Name,
+		State:         model.State,
+		BoardSettings: BuildResponseBoardSettings(model.BoardSettings),
+		Board:         buildGameBoard(model),
+		RowOffsets:    model.Board.RowOffsets(),
+		Seeded:        model.Seeded,
+		StartedAt:     model.StartedAt,
+		FinishedAt:    model.FinishedAt,
+		Elapsed:       model.Elapsed.Seconds(),
+		Assisted:      model.Assisted,
+		MultiLife:     model.MultiLife(),
+		Lives:         model.LivesLeft(),
+		MinesLeft:     model.MinesLeft(),
+		ThreeBV:       buildThreeBV(model),
+		Score:         model.Score,
+		Pauses:        model.Pauses,
+		Detonations:   model.Detonations,
+		Challenge:     model.Challenge,
+	}
+}
+
+// ResponseBoardSettings are the settings a client may see. The seed and the listed mines are left out:
+// they would give the layout away.
+type ResponseBoardSettings struct {
+	Rows       uint             `json:"rows"`
+	Cols       uint             `json:"cols"`
+	Bombs      uint             `json:"bombs"`
+	NoGuess    bool             `json:"no_guess,omitempty"`
+	Difficulty string           `json:"difficulty,omitempty"`
+	Start      *domain.Position `json:"start,omitempty"`
+	Practice   bool             `json:"practice,omitempty"`
+	Topology   string           `json:"topology,omitempty"`
+	Lives      uint             `json:"lives,omitempty"`
+	MaskPaused bool             `json:"mask_paused,omitempty"`
+}
+
+func BuildResponseBoardSettings(model domain.BoardSettings) ResponseBoardSettings {
+	return ResponseBoardSettings{
+		Rows:       model.Rows,
+		Cols:       model.Cols,
+		Bombs:      model.Bombs,
+		NoGuess:    model.NoGuess,
+		Difficulty: model.Difficulty,
+		Start:      model.Start,
+		Practice:   model.Practice,
+		Topology:   model.Topology,
+		Lives:      model.Lives,
+		MaskPaused: model.MaskPaused,
+	}
+}
+
+// BuildBoard renders the board the way clients have always received it:
+// "-" for unopened cells, "F" for flags, "?" for question marks and the adjacent bombs count for revealed cells.
+// Bombs set off in games with several lives are shown as "*".
+func BuildBoard(board domain.Board) [][]string {
+	rendered := make([][]string, len(board.Cells))
+
+	for row := range board.Cells {
+		rendered[row] = make([]string, len(board.Cells[row]))
+
+		for col, cell := range board.Cells[row] {
+			switch {
+			case cell.Revealed:
+				rendered[row][col] = strconv.Itoa(int(cell.Adjacent))
+			case cell.Exploded:
+				rendered[row][col] = CellMineExploded
+			case cell.Flagged:
+				rendered[row][col] = CellFlagged
+			case cell.Questioned:
+				rendered[row][col] = CellQuestioned
+			default:
+				rendered[row][col] = CellHidden
+			}
+		}
+	}
+
+	return rendered
+}
+
+// BuildDisclosedBoard renders the end-of-game view of a full board: every mine is shown,
+// telling apart the one that exploded and the ones that were flagged, and flags placed
+// on safe cells are shown as misplaced.
+func BuildDisclosedBoard(board domain.Board) [][]string {
+	rendered := BuildBoard(board)
+
+	for row := range board.Cells {
+		for col, cell := range board.Cells[row] {
+			switch {
+			case cell.Mine && cell.Exploded:
+				rendered[row][col] = CellMineExploded
+			case cell.Mine && cell.Flagged:
+				rendered[row][col] = CellMineFlagged
+			case cell.Mine:
+				rendered[row][col] = CellMine
+			case cell.Flagged:
+				rendered[row][col] = CellFlagMisplaced
+			}
+		}
+	}
+
+	return rendered
+}
+
+// ··· Private Functions ··· //
+
+// buildThreeBV gives the 3BV of the layout only once the game is won or lost, as it tells something about the bombs.
+func buildThreeBV(model domain.Game) uint {
+	if model.State != domain.GameStateWon && model.State != domain.GameStateLost {
+		return 0
+	}
+
+	return model.ThreeBV
+}
+
+func buildGameBoard(model domain.Game) [][]string {
+	if model.IsOver() {
+		return BuildDisclosedBoard(model.Board)
+	}
+
+	return BuildBoard(model.Board)
+}
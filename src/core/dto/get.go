@@ -0,0 +1,9 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type ResponseGet ResponseGame
+
+func BuildResponseGet(model domain.Game) ResponseGet {
+	return ResponseGet(BuildResponseGame(model))
+}
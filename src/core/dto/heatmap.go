@@ -0,0 +1,9 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type ResponseHeatmap domain.Heatmap
+
+func BuildResponseHeatmap(model domain.Heatmap) ResponseHeatmap {
+	return ResponseHeatmap(model)
+}
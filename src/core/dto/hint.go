@@ -0,0 +1,15 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type ResponseHint struct {
+	Row uint `json:"row"`
+	Col uint `json:"col"`
+}
+
+func BuildResponseHint(model domain.Position) ResponseHint {
+	return ResponseHint{
+		Row: model.Row,
+		Col: model.Col,
+	}
+}
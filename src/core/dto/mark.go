@@ -0,0 +1,14 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type BodyMarkCell struct {
+	Row uint `json:"row"`
+	Col uint `json:"col"`
+}
+
+type ResponseMarkCell ResponseGame
+
+func BuildResponseMarkCell(model domain.Game) ResponseMarkCell {
+	return ResponseMarkCell(BuildResponseGame(model))
+}
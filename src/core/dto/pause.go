@@ -0,0 +1,15 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type ResponsePause ResponseGame
+
+func BuildResponsePause(model domain.Game) ResponsePause {
+	return ResponsePause(BuildResponseGame(model))
+}
+
+type ResponseResume ResponseGame
+
+func BuildResponseResume(model domain.Game) ResponseResume {
+	return ResponseResume(BuildResponseGame(model))
+}
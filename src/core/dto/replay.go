@@ -0,0 +1,27 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type ResponseReplayStep struct {
+	Move  domain.Move `json:"move"`
+	State string      `json:"state"`
+	Board [][]string  `json:"board"`
+}
+
+type ResponseReplay struct {
+	Steps []ResponseReplayStep `json:"steps"`
+}
+
+func BuildResponseReplay(steps []domain.ReplayStep) ResponseReplay {
+	response := ResponseReplay{Steps: make([]ResponseReplayStep, 0, len(steps))}
+
+	for _, step := range steps {
+		response.Steps = append(response.Steps, ResponseReplayStep{
+			Move:  step.Move,
+			State: step.State,
+			Board: BuildDisclosedBoard(step.Board),
+		})
+	}
+
+	return response
+}
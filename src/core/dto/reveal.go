@@ -7,8 +7,8 @@ type BodyRevealCell struct {
 	Col uint `json:"col"`
 }
 
-type ResponseRevealCell domain.Game
+type ResponseRevealCell ResponseGame
 
 func BuildResponseRevealCell(model domain.Game) ResponseRevealCell {
-	return ResponseRevealCell(model)
+	return ResponseRevealCell(BuildResponseGame(model))
 }
@@ -0,0 +1,13 @@
+package dto
+
+import "hexagonal/src/core/domain"
+
+type BodyUndo struct {
+	Moves uint `json:"moves"`
+}
+
+type ResponseUndo ResponseGame
+
+func BuildResponseUndo(model domain.Game) ResponseUndo {
+	return ResponseUndo(BuildResponseGame(model))
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "hexagonal/src/core/domain"
+
+type ChallengeRepositoryPort interface {
+	Get(id string) (domain.Challenge, error)
+	Save(challenge domain.Challenge) error
+}
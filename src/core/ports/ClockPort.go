@@ -0,0 +1,7 @@
+package ports
+
+import "time"
+
+type ClockPort interface {
+	Now() time.Time
+}
@@ -0,0 +1,8 @@
+package ports
+
+import "hexagonal/src/core/domain"
+
+type DailyPort interface {
+	Play(player string) (domain.Game, error)
+	Results(id string) ([]domain.ChallengeResult, error)
+}
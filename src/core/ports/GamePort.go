@@ -4,6 +4,16 @@ import "hexagonal/src/core/domain"
 
 type GamePort interface {
 	Get(id string) (domain.Game, error)
-	Create(name string, size uint, bombs uint) (domain.Game, error)
+	Create(name string, settings domain.BoardSettings) (domain.Game, error)
 	Reveal(id string, row uint, col uint) (domain.Game, error)
+	Flag(id string, row uint, col uint) (domain.Game, error)
+	Mark(id string, row uint, col uint) (domain.Game, error)
+	Chord(id string, row uint, col uint) (domain.Game, error)
+	Hint(id string) (domain.Position, error)
+	Heatmap(id string) (domain.Heatmap, error)
+	Undo(id string, moves uint) (domain.Game, error)
+	Pause(id string) (domain.Game, error)
+	Resume(id string) (domain.Game, error)
+	Abandon(id string) (domain.Game, error)
+	Replay(id string) ([]domain.ReplayStep, error)
 }
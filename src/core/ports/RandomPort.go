@@ -0,0 +1,5 @@
+package ports
+
+type RandomPort interface {
+	Seed() (int64, error)
+}
@@ -0,0 +1,23 @@
+package solver
+
+import (
+	"hexagonal/src/core/ports"
+	"time"
+)
+
+// Deadline tells the solver when to give up. It reads the time from the clock it is given,
+// so callers and tests decide what time it is; the zero Deadline never expires.
+type Deadline struct {
+	clock ports.ClockPort
+	at    time.Time
+}
+
+// NewDeadline returns the deadline that expires timeout from now, as told by the clock.
+func NewDeadline(clock ports.ClockPort, timeout time.Duration) Deadline {
+	return Deadline{clock: clock, at: clock.Now().Add(timeout)}
+}
+
+// Expired tells whether the deadline has been reached.
+func (deadline Deadline) Expired() bool {
+	return deadline.clock != nil && !deadline.clock.Now().Before(deadline.at)
+}
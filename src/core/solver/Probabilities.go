@@ -0,0 +1,461 @@
+package solver
+
+import (
+	"hexagonal/src/core/domain"
+	"math"
+)
+
+const (
+	// exactLimit is the largest group of cells whose bomb layouts are enumerated one by one.
+	exactLimit = 24
+	// deadlineCheck is how many layouts, or constraints, are gone through between checks of the deadline.
+	deadlineCheck = 1024
+)
+
+// group is a set of unrevealed cells tied together by the numbers around them.
+type group struct {
+	cells       []int
+	constraints []constraint
+}
+
+// enumeration counts the bomb layouts of a group that agree with its numbers:
+// counts[k] is how many of them hold k bombs and perCell[k][i] how many of those have a bomb on cell i.
+type enumeration struct {
+	cells   []int
+	counts  []float64
+	perCell [][]float64
+}
+
+// Probabilities works out, from the player-visible board and the bombs of the game, the chance of
+// every unrevealed cell holding a bomb. As with Analyze, flags are ignored and bombs already
+// set off are left out.
+//
+// Cells next to a number are split in independent groups whose layouts are enumerated exactly and
+// then weighed together with the ways of placing the remaining bombs on the cells nobody knows about.
+// Groups larger than exactLimit, or not enumerated before the deadline, are approximated from the
+// density of their numbers, and the heatmap is then reported as not exact. So is it when the groups
+// cannot be weighed together before the deadline: each group cell then gets its frequency among the
+// group layouts.
+func Probabilities(board domain.Board, bombs uint, deadline Deadline) domain.Heatmap {
+	cols := int(board.Cols())
+	constraints := _constraints(board, map[int]bool{}, map[int]bool{})
+	probability := map[int]float64{}
+	exact := true
+	approximated := 0.0
+
+	var enumerations []enumeration
+	for _, g := range _groups(constraints) {
+		if len(g.cells) <= exactLimit {
+			if e, ok := _enumerate(g, deadline); ok {
+				enumerations = append(enumerations, e)
+				continue
+			}
+		}
+
+		exact = false
+		approximated += _approximate(g, probability)
+	}
+
+	unknown := 0
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.Cells[row][col].Exploded {
+				bombs--
+			} else if !board.Cells[row][col].Revealed {
+				unknown++
+			}
+		}
+	}
+
+	interior := unknown - len(probability)
+	for _, e := range enumerations {
+		interior -= len(e.cells)
+	}
+
+	remaining := int(math.Round(float64(bombs) - approximated))
+	density, ok := _combine(enumerations, interior, remaining, probability, deadline)
+	if !ok {
+		exact = false
+		density = _estimate(enumerations, interior, float64(remaining), probability)
+	}
+
+	heatmap := domain.Heatmap{Exact: exact}
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if board.Cells[row][col].Revealed || board.Cells[row][col].Exploded {
+				continue
+			}
+
+			mine, ok := probability[row*cols+col]
+			if !ok {
+				mine = density
+			}
+
+			heatmap.Cells = append(heatmap.Cells, domain.Probability{Row: uint(row), Col: uint(col), Mine: mine})
+		}
+	}
+
+	return heatmap
+}
+
+// ··· Private Functions ··· //
+
+// _groups splits the constraints in groups that share no cell.
+func _groups(constraints []constraint) []group {
+	parent := map[int]int{}
+
+	var find func(cell int) int
+	find = func(cell int) int {
+		if parent[cell] != cell {
+			parent[cell] = find(parent[cell])
+		}
+
+		return parent[cell]
+	}
+
+	for _, c := range constraints {
+		for _, cell := range c.cells {
+			if _, ok := parent[cell]; !ok {
+				parent[cell] = cell
+			}
+		}
+
+		for _, cell := range c.cells[1:] {
+			parent[find(cell)] = find(c.cells[0])
+		}
+	}
+
+	index := map[int]int{}
+	var groups []group
+
+	for _, c := range constraints {
+		root := find(c.cells[0])
+		i, ok := index[root]
+		if !ok {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, group{})
+		}
+
+		groups[i].constraints = append(groups[i].constraints, c)
+	}
+
+	for i := range groups {
+		seen := map[int]bool{}
+
+		for _, c := range groups[i].constraints {
+			for _, cell := range c.cells {
+				if !seen[cell] {
+					seen[cell] = true
+					groups[i].cells = append(groups[i].cells, cell)
+				}
+			}
+		}
+	}
+
+	return groups
+}
+
+// _enumerate tries every bomb layout of the group, giving up when the deadline is reached.
+func _enumerate(g group, deadline Deadline) (enumeration, bool) {
+	local := map[int]int{}
+	for i, cell := range g.cells {
+		local[cell] = i
+	}
+
+	// byCell lists the constraints of each cell, by their position in g.constraints.
+	byCell := make([][]int, len(g.cells))
+	pending := make([]int, len(g.constraints))
+	placed := make([]int, len(g.constraints))
+
+	for j, c := range g.constraints {
+		pending[j] = len(c.cells)
+
+		for _, cell := range c.cells {
+			byCell[local[cell]] = append(byCell[local[cell]], j)
+		}
+	}
+
+	e := enumeration{
+		cells:   g.cells,
+		counts:  make([]float64, len(g.cells)+1),
+		perCell: make([][]float64, len(g.cells)+1),
+	}
+	for k := range e.perCell {
+		e.perCell[k] = make([]float64, len(g.cells))
+	}
+
+	layout := make([]bool, len(g.cells))
+	tried := 0
+	timedOut := false
+
+	var place func(i int, bombs int)
+	place = func(i int, bombs int) {
+		if timedOut {
+			return
+		}
+
+		if tried%deadlineCheck == 0 && deadline.Expired() {
+			timedOut = true
+			return
+		}
+		tried++
+
+		if i == len(g.cells) {
+			e.counts[bombs]++
+			for cell, mine := range layout {
+				if mine {
+					e.perCell[bombs][cell]++
+				}
+			}
+
+			return
+		}
+
+		for _, mine := range []bool{false, true} {
+			fits := true
+
+			for _, j := range byCell[i] {
+				pending[j]--
+				if mine {
+					placed[j]++
+				}
+
+				if placed[j] > g.constraints[j].mines || placed[j]+pending[j] < g.constraints[j].mines {
+					fits = false
+				}
+			}
+
+			if fits {
+				layout[i] = mine
+				if mine {
+					place(i+1, bombs+1)
+				} else {
+					place(i+1, bombs)
+				}
+				layout[i] = false
+			}
+
+			for _, j := range byCell[i] {
+				pending[j]++
+				if mine {
+					placed[j]--
+				}
+			}
+		}
+	}
+
+	place(0, 0)
+
+	return e, !timedOut
+}
+
+// _approximate gives each cell of the group the average density of the numbers around it,
+// and returns the bombs expected in the group.
+func _approximate(g group, probability map[int]float64) float64 {
+	sum := map[int]float64{}
+	seen := map[int]int{}
+
+	for _, c := range g.constraints {
+		for _, cell := range c.cells {
+			sum[cell] += float64(c.mines) / float64(len(c.cells))
+			seen[cell]++
+		}
+	}
+
+	expected := 0.0
+	for _, cell := range g.cells {
+		probability[cell] = math.Min(1, math.Max(0, sum[cell]/float64(seen[cell])))
+		expected += probability[cell]
+	}
+
+	return expected
+}
+
+// _combine weighs the layouts of every group with the ways of placing the remaining bombs
+// on the interior cells, and returns the probability of an interior cell holding a bomb.
+// It fails when no layout fits the number of bombs, or when the deadline is reached.
+//
+// Rather than weighing each group against the layouts of all the others, it goes through the
+// groups twice: backwards, gathering what the groups after each one weigh, and forwards, gathering
+// the layouts of the groups before it. Layouts are counted as shares of their group, so hundreds
+// of groups do not overflow, and only between the fewest and most bombs the group can hold.
+func _combine(enumerations []enumeration, interior int, bombs int, probability map[int]float64, deadline Deadline) (float64, bool) {
+	size := 1
+	fewest := 0
+	lows := make([]int, len(enumerations))
+	shares := make([][]float64, len(enumerations))
+	layouts := make([]float64, len(enumerations))
+
+	for i, e := range enumerations {
+		low, high := len(e.counts), -1
+		for k, count := range e.counts {
+			if count > 0 && low > k {
+				low = k
+			}
+
+			if count > 0 {
+				high = k
+			}
+
+			layouts[i] += count
+		}
+
+		if high < 0 {
+			return 0, false
+		}
+
+		lows[i] = low
+		fewest += low
+		size += high - low
+		shares[i] = make([]float64, high-low+1)
+
+		for k := range shares[i] {
+			shares[i][k] = e.counts[low+k] / layouts[i]
+		}
+	}
+
+	// From here on, bomb counts are over the fewest the groups can hold together.
+	bombs -= fewest
+	weight := _interiorWeights(interior, bombs, size)
+
+	// after[i][j] is the weight of having j bombs in the groups before i: the layouts of the
+	// groups from i on, each times the ways of placing the bombs left on the interior cells.
+	after := make([][]float64, len(enumerations)+1)
+	after[len(enumerations)] = weight
+
+	before := size
+	for i := len(enumerations) - 1; i >= 0; i-- {
+		if deadline.Expired() {
+			return 0, false
+		}
+
+		before -= len(shares[i]) - 1
+		after[i] = make([]float64, before)
+
+		for j := range after[i] {
+			for k, share := range shares[i] {
+				after[i][j] += share * after[i+1][j+k]
+			}
+		}
+	}
+
+	total := after[0][0]
+	if total == 0 {
+		return 0, false
+	}
+
+	// prefix[m] is the share of the layouts of the groups gone through so far holding m bombs.
+	prefix := []float64{1}
+
+	for i, e := range enumerations {
+		if deadline.Expired() {
+			return 0, false
+		}
+
+		ways := make([]float64, len(shares[i]))
+		for k := range shares[i] {
+			for m, share := range prefix {
+				ways[k] += share * after[i+1][m+k]
+			}
+		}
+
+		for cell := range e.cells {
+			mine := 0.0
+			for k := range shares[i] {
+				mine += e.perCell[lows[i]+k][cell] / layouts[i] * ways[k]
+			}
+
+			probability[e.cells[cell]] = mine / total
+		}
+
+		prefix = _convolve(prefix, shares[i])
+	}
+
+	if interior == 0 {
+		return 0, true
+	}
+
+	interiorBombs := 0.0
+	for m, share := range prefix {
+		if bombs-m < 0 {
+			break
+		}
+
+		interiorBombs += share * weight[m] * float64(bombs-m)
+	}
+
+	return interiorBombs / total / float64(interior), true
+}
+
+// _estimate gives each group cell its frequency among the group layouts, regardless of the bombs
+// left, and spreads over the interior the bombs those layouts do not account for.
+func _estimate(enumerations []enumeration, interior int, bombs float64, probability map[int]float64) float64 {
+	for _, e := range enumerations {
+		layouts := 0.0
+		for _, count := range e.counts {
+			layouts += count
+		}
+
+		for cell := range e.cells {
+			mine := 0.0
+			for k := range e.counts {
+				mine += e.perCell[k][cell]
+			}
+
+			probability[e.cells[cell]] = mine / layouts
+			bombs -= probability[e.cells[cell]]
+		}
+	}
+
+	if interior == 0 {
+		return 0
+	}
+
+	return math.Min(1, math.Max(0, bombs/float64(interior)))
+}
+
+// _interiorWeights returns, for every number m of bombs in the groups, the ways of placing the other
+// bombs on the interior cells. They are scaled by the largest one so big boards do not overflow.
+func _interiorWeights(interior int, bombs int, size int) []float64 {
+	logs := make([]float64, size)
+	largest := math.Inf(-1)
+
+	for m := range logs {
+		logs[m] = math.Inf(-1)
+
+		if n := bombs - m; n >= 0 && n <= interior {
+			logs[m] = _logBinomial(interior, n)
+			largest = math.Max(largest, logs[m])
+		}
+	}
+
+	weight := make([]float64, size)
+	for m := range logs {
+		if !math.IsInf(logs[m], -1) {
+			weight[m] = math.Exp(logs[m] - largest)
+		}
+	}
+
+	return weight
+}
+
+func _logBinomial(n int, k int) float64 {
+	a, _ := math.Lgamma(float64(n + 1))
+	b, _ := math.Lgamma(float64(k + 1))
+	c, _ := math.Lgamma(float64(n - k + 1))
+
+	return a - b - c
+}
+
+func _convolve(a []float64, b []float64) []float64 {
+	result := make([]float64, len(a)+len(b)-1)
+
+	for i := range a {
+		for j := range b {
+			result[i+j] += a[i] * b[j]
+		}
+	}
+
+	return result
+}
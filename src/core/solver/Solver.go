@@ -0,0 +1,202 @@
+package solver
+
+import (
+	"hexagonal/src/core/domain"
+	"sort"
+)
+
+// Result holds the unrevealed cells whose content can be told with certainty.
+type Result struct {
+	Safe  []domain.Position `json:"safe"`
+	Mines []domain.Position `json:"mines"`
+}
+
+type constraint struct {
+	cells []int
+	mines int
+}
+
+// Analyze looks at the player-visible board (the output of HideBombs) and finds the
+// unrevealed cells that are certainly safe or certainly mines. Flags are ignored, as
+// they are the player's guesses, not information; bombs already set off are known mines.
+//
+// It combines two rules until nothing new is learnt: a single number whose mines are
+// all accounted for (or whose unknown cells must all be mines), and a number whose
+// unknown cells are a subset of another's, which settles the difference between both.
+// Once the deadline expires it stops, returning only what it had learnt by then.
+func Analyze(board domain.Board, deadline Deadline) Result {
+	cols := int(board.Cols())
+	mines := map[int]bool{}
+	safe := map[int]bool{}
+
+	for changed := true; changed && !deadline.Expired(); {
+		changed = false
+		constraints := _constraints(board, mines, safe)
+
+		for _, c := range constraints {
+			if c.mines == 0 {
+				changed = _mark(safe, c.cells) || changed
+			} else if c.mines == len(c.cells) {
+				changed = _mark(mines, c.cells) || changed
+			}
+		}
+
+		byCell := map[int][]int{}
+		for i, c := range constraints {
+			for _, cell := range c.cells {
+				byCell[cell] = append(byCell[cell], i)
+			}
+		}
+
+		for i, small := range constraints {
+			if i%deadlineCheck == 0 && deadline.Expired() {
+				break
+			}
+
+			checked := map[int]bool{i: true}
+
+			for _, cell := range small.cells {
+				for _, j := range byCell[cell] {
+					if checked[j] {
+						continue
+					}
+					checked[j] = true
+
+					large := constraints[j]
+					rest, ok := _difference(large.cells, small.cells)
+					if !ok || len(rest) == 0 {
+						continue
+					}
+
+					if large.mines == small.mines {
+						changed = _mark(safe, rest) || changed
+					} else if large.mines-small.mines == len(rest) {
+						changed = _mark(mines, rest) || changed
+					}
+				}
+			}
+		}
+	}
+
+	return Result{
+		Safe:  _positions(safe, cols),
+		Mines: _positions(mines, cols),
+	}
+}
+
+// IsSolvable plays the full board from the given cell using Analyze alone,
+// and tells whether every safe cell can be revealed without guessing.
+// Boards not cleared before the deadline are reported as not solvable.
+func IsSolvable(board domain.Board, row uint, col uint, deadline Deadline) bool {
+	played := board.Layout()
+
+	if played.IsMine(row, col) {
+		return false
+	}
+
+	played.RevealArea(row, col)
+
+	for played.IsCellEmpty() {
+		result := Analyze(played.HideBombs(), deadline)
+		if len(result.Safe) == 0 || deadline.Expired() {
+			return false
+		}
+
+		for _, pos := range result.Safe {
+			if !played.IsRevealed(pos.Row, pos.Col) {
+				played.RevealArea(pos.Row, pos.Col)
+			}
+		}
+	}
+
+	return true
+}
+
+// ··· Private Functions ··· //
+func _constraints(board domain.Board, mines map[int]bool, safe map[int]bool) []constraint {
+	cols := int(board.Cols())
+	var constraints []constraint
+
+	for row := range board.Cells {
+		for col := range board.Cells[row] {
+			if !board.Cells[row][col].Revealed {
+				continue
+			}
+
+			c := constraint{mines: int(board.Cells[row][col].Adjacent)}
+			for _, pos := range board.Neighbours(uint(row), uint(col)) {
+				if board.IsRevealed(pos.Row, pos.Col) {
+					continue
+				}
+
+				index := int(pos.Row)*cols + int(pos.Col)
+				if mines[index] || board.IsExploded(pos.Row, pos.Col) {
+					c.mines--
+				} else if !safe[index] {
+					c.cells = append(c.cells, index)
+				}
+			}
+
+			if len(c.cells) > 0 {
+				constraints = append(constraints, c)
+			}
+		}
+	}
+
+	return constraints
+}
+
+// _difference returns the cells of large that are not in small, as long as small is a subset of large.
+func _difference(large []int, small []int) ([]int, bool) {
+	inLarge := map[int]bool{}
+	for _, cell := range large {
+		inLarge[cell] = true
+	}
+
+	for _, cell := range small {
+		if !inLarge[cell] {
+			return nil, false
+		}
+		delete(inLarge, cell)
+	}
+
+	var rest []int
+	for _, cell := range large {
+		if inLarge[cell] {
+			rest = append(rest, cell)
+		}
+	}
+
+	return rest, true
+}
+
+func _mark(set map[int]bool, cells []int) bool {
+	changed := false
+
+	for _, cell := range cells {
+		if !set[cell] {
+			set[cell] = true
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+func _positions(set map[int]bool, cols int) []domain.Position {
+	var positions []domain.Position
+
+	for index := range set {
+		positions = append(positions, domain.Position{Row: uint(index / cols), Col: uint(index % cols)})
+	}
+
+	sort.Slice(positions, func(i, j int) bool {
+		if positions[i].Row != positions[j].Row {
+			return positions[i].Row < positions[j].Row
+		}
+
+		return positions[i].Col < positions[j].Col
+	})
+
+	return positions
+}
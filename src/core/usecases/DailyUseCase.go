@@ -0,0 +1,180 @@
+package usecases
+
+import (
+	"github.com/matiasvarela/errors"
+	"hexagonal/src/config/apperrors"
+	"hexagonal/src/config/messages"
+	"hexagonal/src/config/uuid"
+	"hexagonal/src/core/domain"
+	"hexagonal/src/core/ports"
+	"sort"
+	"time"
+)
+
+// dailySettings is the board of every daily challenge. The seed is set per challenge.
+var dailySettings = domain.BoardSettings{Difficulty: domain.DifficultyIntermediate}
+
+type DailyUseCase struct {
+	gamesRepository      ports.GameRepositoryPort
+	challengesRepository ports.ChallengeRepositoryPort
+	uuid                 uuid.Generator
+	clock                ports.ClockPort
+	secret               string
+}
+
+func NewDaily(gamesRepository ports.GameRepositoryPort, challengesRepository ports.ChallengeRepositoryPort, uuid uuid.Generator, clock ports.ClockPort, secret string) *DailyUseCase {
+	return &DailyUseCase{
+		gamesRepository:      gamesRepository,
+		challengesRepository: challengesRepository,
+		uuid:                 uuid,
+		clock:                clock,
+		secret:               secret,
+	}
+}
+
+// Play returns the game the player has for today's challenge, creating it on their first call.
+// Every player gets the same board, with the same cell already opened, and one game per day.
+func (dailyUseCase *DailyUseCase) Play(player string) (domain.Game, error) {
+	if player == "" {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.ChallengePlayerMissing)
+	}
+
+	now := dailyUseCase.clock.Now()
+	id := domain.ChallengeID(now)
+
+	challenge, err := dailyUseCase.challengesRepository.Get(id)
+	if err != nil {
+		if !errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.Internal, err, messages.ChallengeFailedFromRepository)
+		}
+
+		challenge = domain.Challenge{ID: id}
+	}
+
+	if gameID, ok := challenge.GameOf(player); ok {
+		game, err := dailyUseCase.gamesRepository.Get(gameID)
+		if err != nil {
+			return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+		}
+
+		game.Board = game.VisibleBoard()
+		game.Elapsed = game.ElapsedAt(now)
+
+		return game, nil
+	}
+
+	game := dailyGame(dailyUseCase.uuid.New(), id, dailyUseCase.secret)
+
+	if err := dailyUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeCreatedFromRepository)
+	}
+
+	challenge.Entries = append(challenge.Entries, domain.ChallengeEntry{Player: player, GameID: game.ID})
+
+	if err := dailyUseCase.challengesRepository.Save(challenge); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.ChallengeCannotBeSaved)
+	}
+
+	game.Board = game.VisibleBoard()
+
+	return game, nil
+}
+
+// Results lists how every player did on the challenge of the day: games won without help first, fastest first,
+// then games won with hints, undos or spare lives, fastest first, then the others in the order they were started.
+// The games themselves are only given once the day is over.
+func (dailyUseCase *DailyUseCase) Results(id string) ([]domain.ChallengeResult, error) {
+	if _, err := time.Parse(domain.ChallengeDayLayout, id); err != nil {
+		return nil, errors.New(apperrors.InvalidInput, err, messages.ChallengeDayInvalid)
+	}
+
+	challenge, err := dailyUseCase.challengesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return []domain.ChallengeResult{}, nil
+		}
+
+		return nil, errors.New(apperrors.Internal, err, messages.ChallengeFailedFromRepository)
+	}
+
+	now := dailyUseCase.clock.Now()
+	results := make([]domain.ChallengeResult, 0, len(challenge.Entries))
+
+	// The game endpoints show the whole board of a finished game, so the games of a challenge
+	// are only listed once its day is over.
+	running := id >= domain.ChallengeID(now)
+
+	for _, entry := range challenge.Entries {
+		game, err := dailyUseCase.gamesRepository.Get(entry.GameID)
+		if err != nil {
+			return nil, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+		}
+
+		result := domain.ChallengeResult{
+			Player:    entry.Player,
+			GameID:    game.ID,
+			State:     game.State,
+			Elapsed:   game.ElapsedAt(now),
+			Assisted:  game.Assisted,
+			MultiLife: game.MultiLife(),
+			Score:     game.Score,
+		}
+
+		if running {
+			result.GameID = ""
+		}
+
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		iRank, jRank := dailyRank(results[i]), dailyRank(results[j])
+		if iRank == jRank && iRank != dailyRankUnfinished {
+			return results[i].Elapsed < results[j].Elapsed
+		}
+
+		return iRank < jRank
+	})
+
+	return results, nil
+}
+
+// ··· Private Functions ··· //
+
+// Ranks of the daily results. An assisted or multi-life win never competes on time with a win played unaided.
+const (
+	dailyRankWon = iota
+	dailyRankAssisted
+	dailyRankUnfinished
+)
+
+func dailyRank(result domain.ChallengeResult) int {
+	switch {
+	case result.State != domain.GameStateWon:
+		return dailyRankUnfinished
+	case result.Assisted || result.MultiLife:
+		return dailyRankAssisted
+	default:
+		return dailyRankWon
+	}
+}
+
+// dailyGame lays the board of the challenge from a seed only the server can work out,
+// opening its center cell so every player starts from the same position.
+func dailyGame(gameID string, challengeID string, secret string) domain.Game {
+	settings, _ := dailySettings.WithDifficulty()
+	seed := domain.ChallengeSeed(secret, challengeID)
+	settings.Seed = &seed
+
+	start := domain.Position{Row: settings.Rows / 2, Col: settings.Cols / 2}
+
+	game := domain.NewGame(gameID, "daily "+challengeID, settings)
+	game.Challenge = challengeID
+	game.Seed(start.Row, start.Col)
+	game.OpenStart(start)
+
+	// The seed would give the whole board away, and it is no longer needed once the bombs are placed.
+	game.BoardSettings.Seed = nil
+
+	return game
+}
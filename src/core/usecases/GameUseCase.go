@@ -7,17 +7,32 @@ import (
 	"hexagonal/src/config/uuid"
 	"hexagonal/src/core/domain"
 	"hexagonal/src/core/ports"
+	"hexagonal/src/core/solver"
+	"math/rand"
+	"time"
+)
+
+const (
+	noGuessAttempts = 500
+	noGuessTimeout  = 2 * time.Second
+	noGuessMaxCells = 2500
+	hintTimeout     = time.Second
+	heatmapTimeout  = time.Second
 )
 
 type GameUseCase struct {
 	gamesRepository ports.GameRepositoryPort
 	uuid            uuid.Generator
+	clock           ports.ClockPort
+	random          ports.RandomPort
 }
 
-func New(gamesRepository ports.GameRepositoryPort, uuid uuid.Generator) *GameUseCase {
+func New(gamesRepository ports.GameRepositoryPort, uuid uuid.Generator, clock ports.ClockPort, random ports.RandomPort) *GameUseCase {
 	return &GameUseCase{
 		gamesRepository: gamesRepository,
 		uuid:            uuid,
+		clock:           clock,
+		random:          random,
 	}
 }
 
@@ -31,23 +46,81 @@ func (gameUseCase *GameUseCase) Get(id string) (domain.Game, error) {
 		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
 	}
 
-	game.Board = game.Board.HideBombs()
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(gameUseCase.clock.Now())
 
 	return game, nil
 }
 
-func (gameUseCase *GameUseCase) Create(name string, size uint, bombs uint) (domain.Game, error) {
-	if bombs >= size*size {
+func (gameUseCase *GameUseCase) Create(name string, settings domain.BoardSettings) (domain.Game, error) {
+	settings, ok := settings.WithDifficulty()
+	if !ok {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameDifficultyUnknown)
+	}
+
+	if settings.Rows == 0 {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameRowsMissing)
+	}
+
+	if settings.Cols == 0 {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameColsMissing)
+	}
+
+	if _, ok := domain.TopologyOf(settings.Topology); !ok {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameTopologyUnknown)
+	}
+
+	// Mines are only laid on custom boards, whose rows and columns were given and checked above.
+	if len(settings.Mines) > 0 {
+		if settings.Difficulty != domain.DifficultyCustom {
+			return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameMinesWithDifficulty)
+		}
+
+		settings.Bombs = uint(len(settings.Mines))
+	}
+
+	if settings.Bombs == 0 {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameBombsMissing)
+	}
+
+	if settings.Bombs >= settings.Rows*settings.Cols {
 		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameBombsTooHigh)
 	}
 
-	game := domain.NewGame(gameUseCase.uuid.New(), name, size, bombs)
+	// With more lives than bombs the game could not be lost. Single-life games are never affected.
+	if settings.Lives > 1 && settings.Lives > settings.Bombs {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameLivesTooHigh)
+	}
+
+	if settings.NoGuess && settings.Rows*settings.Cols > noGuessMaxCells {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameNoGuessTooLarge)
+	}
+
+	if err := checkMines(settings); err != nil {
+		return domain.Game{}, err
+	}
+
+	if err := gameUseCase.drawSeed(&settings); err != nil {
+		return domain.Game{}, err
+	}
+
+	game := domain.NewGame(gameUseCase.uuid.New(), name, settings)
+
+	if len(settings.Mines) > 0 {
+		game.SetLayout(settings.MinedBoard())
+	}
+
+	if settings.NoGuess {
+		if err := seedWithoutGuessing(&game, solver.NewDeadline(gameUseCase.clock, noGuessTimeout)); err != nil {
+			return domain.Game{}, err
+		}
+	}
 
 	if err := gameUseCase.gamesRepository.Save(game); err != nil {
 		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeCreatedFromRepository)
 	}
 
-	game.Board = game.Board.HideBombs()
+	game.Board = game.VisibleBoard()
 
 	return game, nil
 }
@@ -67,25 +140,592 @@ func (gameUseCase *GameUseCase) Reveal(id string, row uint, col uint) (domain.Ga
 		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameInvalidPosition)
 	}
 
-	if game.IsOver() {
-		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameOver)
+	if err := allow(game, domain.GameEventPlay); err != nil {
+		return domain.Game{}, err
+	}
+
+	if game.Board.IsFlagged(row, col) {
+		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameCellFlagged)
+	}
+
+	if game.Board.IsRevealed(row, col) || game.Board.IsExploded(row, col) {
+		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameCellRevealed)
+	}
+
+	// Games stored before seeds were kept in their settings get one before their bombs are placed.
+	if !game.Seeded {
+		if err := gameUseCase.drawSeed(&game.BoardSettings); err != nil {
+			return domain.Game{}, err
+		}
+	}
+
+	now := gameUseCase.clock.Now()
+	play(&game, domain.Move{Action: domain.MoveReveal, Row: row, Col: col, At: now})
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(now)
+
+	return game, nil
+}
+
+func (gameUseCase *GameUseCase) Flag(id string, row uint, col uint) (domain.Game, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if !game.Board.IsValidPosition(row, col) {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameInvalidPosition)
+	}
+
+	if err := allow(game, domain.GameEventPlay); err != nil {
+		return domain.Game{}, err
+	}
+
+	if game.Board.IsRevealed(row, col) || game.Board.IsExploded(row, col) {
+		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameCellRevealed)
+	}
+
+	now := gameUseCase.clock.Now()
+	play(&game, domain.Move{Action: domain.MoveFlag, Row: row, Col: col, At: now})
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(now)
+
+	return game, nil
+}
+
+// Mark cycles the mark of a cell through a flag, a question mark and no mark at all.
+func (gameUseCase *GameUseCase) Mark(id string, row uint, col uint) (domain.Game, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if !game.Board.IsValidPosition(row, col) {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameInvalidPosition)
 	}
 
-	if game.Board.Contains(row, col, domain.CellBomb) {
-		game.State = domain.GameStateLost
-	} else {
-		game.Board.Set(row, col, domain.CellRevealed)
+	if err := allow(game, domain.GameEventPlay); err != nil {
+		return domain.Game{}, err
+	}
+
+	if game.Board.IsRevealed(row, col) || game.Board.IsExploded(row, col) {
+		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameCellRevealed)
+	}
+
+	now := gameUseCase.clock.Now()
+	play(&game, domain.Move{Action: domain.MoveMark, Row: row, Col: col, At: now})
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(now)
+
+	return game, nil
+}
 
-		if !game.Board.IsCellEmpty() {
-			game.State = domain.GameStateWon
+func (gameUseCase *GameUseCase) Chord(id string, row uint, col uint) (domain.Game, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
 		}
+
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if !game.Board.IsValidPosition(row, col) {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameInvalidPosition)
 	}
 
+	if err := allow(game, domain.GameEventPlay); err != nil {
+		return domain.Game{}, err
+	}
+
+	if !game.Board.IsRevealed(row, col) {
+		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameCellNotRevealed)
+	}
+
+	if game.Board.CountAdjacentFlags(row, col) != game.Board.CountAdjacentBombs(row, col) {
+		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameChordNotSatisfied)
+	}
+
+	now := gameUseCase.clock.Now()
+	play(&game, domain.Move{Action: domain.MoveChord, Row: row, Col: col, At: now})
+
 	if err := gameUseCase.gamesRepository.Save(game); err != nil {
 		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
 	}
 
-	game.Board = game.Board.HideBombs()
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(now)
 
 	return game, nil
 }
+
+// Hint returns a cell the player can prove safe from what the board shows, preferring the ones
+// not flagged by mistake. Before the first reveal every cell is safe, so the center is suggested.
+// The game is marked as assisted so it is kept out of rankings.
+func (gameUseCase *GameUseCase) Hint(id string) (domain.Position, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Position{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Position{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if err := allow(game, domain.GameEventAssist); err != nil {
+		return domain.Position{}, err
+	}
+
+	hint, err := safeCell(game, solver.NewDeadline(gameUseCase.clock, hintTimeout))
+	if err != nil {
+		return domain.Position{}, err
+	}
+
+	game.Assisted = true
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Position{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	return hint, nil
+}
+
+// Heatmap returns the bomb probability of every unrevealed cell, worked out from what the player sees.
+// Like a hint, it marks the game as assisted.
+func (gameUseCase *GameUseCase) Heatmap(id string) (domain.Heatmap, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Heatmap{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Heatmap{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if err := allow(game, domain.GameEventAssist); err != nil {
+		return domain.Heatmap{}, err
+	}
+
+	heatmap := solver.Probabilities(game.Board.HideBombs(), game.BoardSettings.Bombs, solver.NewDeadline(gameUseCase.clock, heatmapTimeout))
+	game.Assisted = true
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Heatmap{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	return heatmap, nil
+}
+
+// Undo takes back the last moves of a practice game, even the one that lost it,
+// by playing its history again from the initial layout without them.
+func (gameUseCase *GameUseCase) Undo(id string, moves uint) (domain.Game, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if !game.BoardSettings.Practice {
+		return domain.Game{}, errors.New(apperrors.IllegalOperation, nil, messages.GameNotPractice)
+	}
+
+	if err := allow(game, domain.GameEventRewind); err != nil {
+		return domain.Game{}, err
+	}
+
+	if moves == 0 {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameUndoMissing)
+	}
+
+	if moves > uint(len(game.Moves)) {
+		return domain.Game{}, errors.New(apperrors.InvalidInput, nil, messages.GameUndoTooHigh)
+	}
+
+	game = rewind(game, len(game.Moves)-int(moves))
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(gameUseCase.clock.Now())
+
+	return game, nil
+}
+
+// Pause stops the clock of a game in progress. No move can be played until it is resumed.
+func (gameUseCase *GameUseCase) Pause(id string) (domain.Game, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if err := allow(game, domain.GameEventPause); err != nil {
+		return domain.Game{}, err
+	}
+
+	now := gameUseCase.clock.Now()
+	game.Transition(domain.GameEventPause)
+	game.PauseAt(now)
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(now)
+
+	return game, nil
+}
+
+// Resume starts the clock of a paused game again.
+func (gameUseCase *GameUseCase) Resume(id string) (domain.Game, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if err := allow(game, domain.GameEventResume); err != nil {
+		return domain.Game{}, err
+	}
+
+	now := gameUseCase.clock.Now()
+	game.Transition(domain.GameEventResume)
+	game.ResumeAt(now)
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(now)
+
+	return game, nil
+}
+
+// Abandon gives up a game that is not over yet, disclosing its board.
+func (gameUseCase *GameUseCase) Abandon(id string) (domain.Game, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return domain.Game{}, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if err := allow(game, domain.GameEventAbandon); err != nil {
+		return domain.Game{}, err
+	}
+
+	now := gameUseCase.clock.Now()
+	game.Transition(domain.GameEventAbandon)
+	game.TrackTime(now)
+
+	if err := gameUseCase.gamesRepository.Save(game); err != nil {
+		return domain.Game{}, errors.New(apperrors.Internal, err, messages.GameCannotBeUpdateFromRepository)
+	}
+
+	game.Board = game.VisibleBoard()
+	game.Elapsed = game.ElapsedAt(now)
+
+	return game, nil
+}
+
+// Replay plays the history of a finished game again from its initial layout,
+// returning the whole board after each move.
+func (gameUseCase *GameUseCase) Replay(id string) ([]domain.ReplayStep, error) {
+	game, err := gameUseCase.gamesRepository.Get(id)
+	if err != nil {
+		if errors.Is(err, apperrors.NotFound) {
+			return nil, errors.New(apperrors.NotFound, err, messages.GameNotFound)
+		}
+
+		return nil, errors.New(apperrors.Internal, err, messages.GameFailedFromRepository)
+	}
+
+	if !game.Can(domain.GameEventReview) {
+		return nil, errors.New(apperrors.IllegalOperation, nil, messages.GameNotOver)
+	}
+
+	replayed := game.Restart()
+	steps := make([]domain.ReplayStep, 0, len(game.Moves))
+
+	for _, move := range game.Moves {
+		applyMove(&replayed, move)
+
+		steps = append(steps, domain.ReplayStep{
+			Move:  move,
+			State: replayed.State,
+			Board: replayed.Board.Copy(),
+		})
+	}
+
+	return steps, nil
+}
+
+// ··· Private Functions ··· //
+
+// allow checks that the state of the game lets the event happen, telling finished and paused games apart.
+func allow(game domain.Game, event string) error {
+	if game.Can(event) {
+		return nil
+	}
+
+	if game.IsOver() {
+		return errors.New(apperrors.IllegalOperation, nil, messages.GameOver)
+	}
+
+	if game.State == domain.GameStatePaused {
+		return errors.New(apperrors.IllegalOperation, nil, messages.GamePaused)
+	}
+
+	return errors.New(apperrors.IllegalOperation, nil, messages.GameIllegalTransition)
+}
+
+// drawSeed gives the settings a seed from the random port unless they were given one,
+// so every layout can be reproduced.
+func (gameUseCase *GameUseCase) drawSeed(settings *domain.BoardSettings) error {
+	if settings.Seed != nil {
+		return nil
+	}
+
+	seed, err := gameUseCase.random.Seed()
+	if err != nil {
+		return errors.New(apperrors.Internal, err, messages.GameSeedFailed)
+	}
+
+	settings.Seed = &seed
+
+	return nil
+}
+
+// checkMines makes sure the listed mines are inside the board and listed once each.
+func checkMines(settings domain.BoardSettings) error {
+	if len(settings.Mines) == 0 {
+		return nil
+	}
+
+	if settings.NoGuess {
+		return errors.New(apperrors.InvalidInput, nil, messages.GameMinesWithNoGuess)
+	}
+
+	board := settings.EmptyBoard()
+	listed := make(map[domain.Position]bool, len(settings.Mines))
+
+	for _, mine := range settings.Mines {
+		if !board.IsValidPosition(mine.Row, mine.Col) {
+			return errors.New(apperrors.InvalidInput, nil, messages.GameMineOutOfBoard)
+		}
+
+		if listed[mine] {
+			return errors.New(apperrors.InvalidInput, nil, messages.GameMineDuplicated)
+		}
+
+		listed[mine] = true
+	}
+
+	return nil
+}
+
+// seedWithoutGuessing keeps generating layouts from the game seed until the solver can clear
+// one from the center cell by deduction alone, and opens that cell for the player.
+// Layouts the opening clears on its own are skipped, as the player would have no safe cell left to reveal.
+// It gives up after noGuessAttempts layouts or noGuessTimeout, whichever comes first.
+func seedWithoutGuessing(game *domain.Game, deadline solver.Deadline) error {
+	settings := game.BoardSettings
+	start := domain.Position{Row: settings.Rows / 2, Col: settings.Cols / 2}
+	random := rand.New(rand.NewSource(*settings.Seed))
+
+	for attempt := 0; attempt < noGuessAttempts && !deadline.Expired(); attempt++ {
+		board := settings.EmptyBoard()
+		board.PlaceBombs(settings.Bombs, start.Row, start.Col, random)
+
+		opened := board.Copy()
+		opened.RevealArea(start.Row, start.Col)
+		if !opened.IsCellEmpty() {
+			continue
+		}
+
+		if solver.IsSolvable(board, start.Row, start.Col, deadline) {
+			game.SetLayout(board)
+			game.OpenStart(start)
+
+			return nil
+		}
+	}
+
+	return errors.New(apperrors.InvalidInput, nil, messages.GameNoGuessNotPossible)
+}
+
+// play applies the move, keeps it in the game history and tracks the time played.
+// Won games are scored right away.
+func play(game *domain.Game, move domain.Move) {
+	move.Outcome = applyMove(game, move)
+	move.State = game.State
+
+	game.Moves = append(game.Moves, move)
+	game.TrackTime(move.At)
+
+	if game.State == domain.GameStateWon {
+		game.ScoreWin()
+	}
+}
+
+// safeCell finds a cell the solver proves safe on the board the player sees before the deadline.
+// Before the bombs are placed every cell is safe, so the first one not flagged from the center on is given.
+// Flagged cells are never given, as they cannot be revealed.
+func safeCell(game domain.Game, deadline solver.Deadline) (domain.Position, error) {
+	if !game.Seeded {
+		rows, cols := game.Board.Rows(), game.Board.Cols()
+		center := (rows/2)*cols + cols/2
+
+		for i := uint(0); i < rows*cols; i++ {
+			cell := (center + i) % (rows * cols)
+			if !game.Board.IsFlagged(cell/cols, cell%cols) {
+				return domain.Position{Row: cell / cols, Col: cell % cols}, nil
+			}
+		}
+
+		return domain.Position{}, errors.New(apperrors.IllegalOperation, nil, messages.GameNoHintUnflagged)
+	}
+
+	safe := solver.Analyze(game.Board.HideBombs(), deadline).Safe
+	if len(safe) == 0 {
+		return domain.Position{}, errors.New(apperrors.IllegalOperation, nil, messages.GameNoHint)
+	}
+
+	for _, pos := range safe {
+		if !game.Board.IsFlagged(pos.Row, pos.Col) {
+			return pos, nil
+		}
+	}
+
+	return domain.Position{}, errors.New(apperrors.IllegalOperation, nil, messages.GameNoHintUnflagged)
+}
+
+// rewind returns the game as it was after its first kept moves. The time played keeps running.
+// A game rewound before its first reveal gets its bombs placed again, so the next first click is still safe.
+// Boards created from a list of mines keep them.
+func rewind(game domain.Game, kept int) domain.Game {
+	rewound := game.Restart()
+	rewound.StartedAt = game.StartedAt
+	rewound.Assisted = game.Assisted
+	rewound.Pauses = game.Pauses
+
+	if kept == 0 && game.BoardSettings.Start == nil && len(game.BoardSettings.Mines) == 0 {
+		rewound.Board = game.BoardSettings.EmptyBoard()
+		rewound.Seeded = false
+		rewound.ThreeBV = 0
+	}
+
+	for _, move := range game.Moves[:kept] {
+		applyMove(&rewound, move)
+	}
+
+	rewound.Moves = append(rewound.Moves, game.Moves[:kept]...)
+
+	return rewound
+}
+
+// applyMove carries out a move that was already checked against the game rules and returns its outcome.
+func applyMove(game *domain.Game, move domain.Move) string {
+	game.Transition(domain.GameEventPlay)
+
+	switch move.Action {
+	case domain.MoveFlag:
+		game.Board.ToggleFlag(move.Row, move.Col)
+
+		if game.Board.IsFlagged(move.Row, move.Col) {
+			return domain.MoveOutcomeFlagged
+		}
+
+		return domain.MoveOutcomeUnflagged
+	case domain.MoveMark:
+		game.Board.CycleMark(move.Row, move.Col)
+
+		switch {
+		case game.Board.IsFlagged(move.Row, move.Col):
+			return domain.MoveOutcomeFlagged
+		case game.Board.IsQuestioned(move.Row, move.Col):
+			return domain.MoveOutcomeQuestioned
+		}
+
+		return domain.MoveOutcomeUnmarked
+	case domain.MoveChord:
+		outcome := domain.MoveOutcomeOpened
+
+		for _, neighbour := range game.Board.HiddenNeighbours(move.Row, move.Col) {
+			if game.IsOver() {
+				break
+			}
+
+			if openCell(game, neighbour.Row, neighbour.Col) {
+				outcome = domain.MoveOutcomeExploded
+			}
+		}
+
+		return outcome
+	default:
+		if !game.Seeded {
+			game.Seed(move.Row, move.Col)
+		}
+
+		if openCell(game, move.Row, move.Col) {
+			return domain.MoveOutcomeExploded
+		}
+
+		return domain.MoveOutcomeOpened
+	}
+}
+
+// openCell reveals a cell applying the rules for lost and won games, and tells whether a bomb exploded.
+// A bomb costs a life, and the game goes on while there are lives left.
+func openCell(game *domain.Game, row uint, col uint) bool {
+	if game.Board.IsMine(row, col) {
+		game.Detonate(row, col)
+		return true
+	}
+
+	game.Board.RevealArea(row, col)
+
+	if !game.Board.IsCellEmpty() {
+		game.Transition(domain.GameEventWin)
+	}
+
+	return false
+}
@@ -1,42 +1,57 @@
 package tests
 
 import (
+	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"hexagonal/src/core/domain"
+	"hexagonal/src/core/dto"
+	"math/rand"
 	"testing"
+	"time"
 )
 
 func TestNewBoard(t *testing.T) {
 	size := uint(10)
 	bombs := uint(10)
 
-	board := domain.NewBoard(size, bombs)
+	board := domain.NewBoard(size, size, bombs, rand.New(rand.NewSource(1)))
 
-	countBombs := uint(0)
-	for i := range board {
-		for j := range board[0] {
-			if board[i][j] == domain.CellBomb {
-				countBombs++
-			}
-		}
-	}
+	assert.Equal(t, board.Rows(), size)
+	assert.Equal(t, board.Cols(), size)
+	assert.Equal(t, countMines(board), bombs)
+}
+
+func TestNewBoardIsReproducible(t *testing.T) {
+	board := domain.NewBoard(16, 30, 99, rand.New(rand.NewSource(2021)))
+	sameSeed := domain.NewBoard(16, 30, 99, rand.New(rand.NewSource(2021)))
+	otherSeed := domain.NewBoard(16, 30, 99, rand.New(rand.NewSource(2022)))
+
+	assert.Equal(t, board, sameSeed)
+	assert.NotEqual(t, board, otherSeed)
+}
+
+func TestNewRectangularBoard(t *testing.T) {
+	board := domain.NewBoard(16, 30, 99, rand.New(rand.NewSource(1)))
 
-	assert.Equal(t, uint(len(board)), size)
-	assert.Equal(t, uint(len(board[0])), size)
-	assert.Equal(t, countBombs, bombs)
+	assert.Equal(t, uint(16), board.Rows())
+	assert.Equal(t, uint(30), board.Cols())
+	assert.Equal(t, uint(99), countMines(board))
+	assert.True(t, board.IsValidPosition(15, 29))
+	assert.False(t, board.IsValidPosition(29, 15))
+	assert.Equal(t, 30, len(board.HideBombs().Cells[0]))
 }
 
 func TestBoardHiddenBombs(t *testing.T) {
-	board := domain.NewBoard(10, 50).HideBombs()
+	board := domain.NewBoard(10, 10, 50, rand.New(rand.NewSource(1))).HideBombs()
 	isBombHidden := true
 
-	for i := range board {
+	for i := range board.Cells {
 		if !isBombHidden {
 			break
 		}
 
-		for j := range board[0] {
-			if board[i][j] != domain.CellEmpty {
+		for j := range board.Cells[i] {
+			if board.Cells[i][j] != (domain.Cell{}) {
 				isBombHidden = false
 				break
 			}
@@ -47,7 +62,7 @@ func TestBoardHiddenBombs(t *testing.T) {
 }
 
 func TestBoardIsPositionValid(t *testing.T) {
-	board := domain.NewBoard(10, 50)
+	board := domain.NewBoard(10, 10, 50, rand.New(rand.NewSource(1)))
 
 	assert.True(t, board.IsValidPosition(5, 5))
 	assert.False(t, board.IsValidPosition(11, 5))
@@ -55,43 +70,817 @@ func TestBoardIsPositionValid(t *testing.T) {
 }
 
 func TestBoardContainsBombs(t *testing.T) {
-	board := domain.NewBoard(10, 50)
-	board[1][2] = domain.CellBomb
+	board := domain.NewEmptyBoard(10, 10)
+	board.SetMine(1, 2)
 
-	assert.True(t, board.Contains(1, 2, domain.CellBomb))
+	assert.True(t, board.IsMine(1, 2))
+	assert.False(t, board.IsMine(2, 1))
 }
 
 func TestBoardIsCellEmpty(t *testing.T) {
-	boardWithEmptyCells := domain.NewBoard(10, 50)
-	boardWithoutEmptyCells := domain.NewBoard(10, 100)
+	boardWithEmptyCells := domain.NewBoard(10, 10, 50, rand.New(rand.NewSource(1)))
+	boardWithoutEmptyCells := domain.NewBoard(10, 10, 100, rand.New(rand.NewSource(1)))
 
 	assert.True(t, boardWithEmptyCells.IsCellEmpty())
 	assert.False(t, boardWithoutEmptyCells.IsCellEmpty())
 }
 
+func TestBoardCountAdjacentBombs(t *testing.T) {
+	// X - -
+	// - X -
+	// - - X
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.SetMine(1, 1)
+	board.SetMine(2, 2)
+
+	tests := []struct {
+		name string
+		row  uint
+		col  uint
+		want uint
+	}{
+		{name: "top left corner", row: 0, col: 0, want: 1},
+		{name: "top right corner", row: 0, col: 2, want: 1},
+		{name: "bottom left corner", row: 2, col: 0, want: 1},
+		{name: "bottom right corner", row: 2, col: 2, want: 1},
+		{name: "top edge", row: 0, col: 1, want: 2},
+		{name: "left edge", row: 1, col: 0, want: 2},
+		{name: "right edge", row: 1, col: 2, want: 2},
+		{name: "bottom edge", row: 2, col: 1, want: 2},
+		{name: "center", row: 1, col: 1, want: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, board.CountAdjacentBombs(tt.row, tt.col))
+		})
+	}
+}
+
+func TestBoardReveal(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.SetMine(0, 1)
+
+	board.Reveal(1, 1)
+	board.Reveal(2, 2)
+
+	assert.Equal(t, domain.Cell{Revealed: true, Adjacent: 2}, board.Cells[1][1])
+	assert.Equal(t, domain.Cell{Revealed: true}, board.Cells[2][2])
+	assert.Equal(t, domain.Cell{Revealed: true, Adjacent: 2}, board.HideBombs().Cells[1][1])
+	assert.Equal(t, domain.Cell{}, board.HideBombs().Cells[1][0])
+}
+
+func TestBoardRevealArea(t *testing.T) {
+	// X - - -
+	// - - - -
+	// - - - -
+	// - - X -
+	board := domain.NewEmptyBoard(4, 4)
+	board.SetMine(0, 0)
+	board.SetMine(3, 2)
+
+	board.RevealArea(0, 3)
+
+	want := [][]string{
+		{"-", "1", "0", "0"},
+		{"-", "1", "0", "0"},
+		{"-", "1", "1", "1"},
+		{"-", "-", "-", "-"},
+	}
+
+	assert.Equal(t, want, dto.BuildBoard(board))
+}
+
+func TestBoardRevealAreaNumberedCell(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+
+	board.RevealArea(1, 1)
+
+	assert.Equal(t, domain.Cell{Revealed: true, Adjacent: 1}, board.Cells[1][1])
+	assert.False(t, board.IsRevealed(2, 2))
+}
+
+func TestBoardRevealAreaLargeBoard(t *testing.T) {
+	board := domain.NewEmptyBoard(1000, 1000)
+
+	board.RevealArea(500, 500)
+
+	assert.False(t, board.IsCellEmpty())
+}
+
+func TestBoardToggleFlag(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.Reveal(2, 2)
+
+	board.ToggleFlag(0, 0)
+	board.ToggleFlag(1, 1)
+	board.ToggleFlag(2, 2)
+
+	assert.True(t, board.IsFlagged(0, 0))
+	assert.True(t, board.IsFlagged(1, 1))
+	assert.False(t, board.IsFlagged(2, 2))
+	assert.Equal(t, domain.Cell{Flagged: true}, board.HideBombs().Cells[0][0])
+	assert.Equal(t, uint(1), board.CountAdjacentBombs(1, 1))
+
+	board.ToggleFlag(0, 0)
+	board.ToggleFlag(1, 1)
+
+	assert.Equal(t, domain.Cell{Mine: true}, board.Cells[0][0])
+	assert.Equal(t, domain.Cell{Adjacent: 1}, board.Cells[1][1])
+}
+
+func TestBoardIsRevealed(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.Reveal(1, 1)
+	board.ToggleFlag(2, 2)
+
+	assert.False(t, board.IsRevealed(0, 0))
+	assert.True(t, board.IsRevealed(1, 1))
+	assert.False(t, board.IsRevealed(2, 2))
+	assert.False(t, board.IsRevealed(0, 2))
+}
+
+func TestBoardHiddenNeighbours(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.ToggleFlag(0, 0)
+	board.Reveal(0, 1)
+	board.Reveal(1, 1)
+
+	assert.Equal(t, uint(1), board.CountAdjacentFlags(1, 1))
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 2}, {Row: 1, Col: 0}, {Row: 1, Col: 2}, {Row: 2, Col: 0}, {Row: 2, Col: 1}, {Row: 2, Col: 2}}, board.HiddenNeighbours(1, 1))
+	assert.Equal(t, []domain.Position{{Row: 1, Col: 0}}, board.HiddenNeighbours(0, 0))
+}
+
+func TestBoardPlaceBombs(t *testing.T) {
+	tests := []struct {
+		name      string
+		size      uint
+		bombs     uint
+		clearArea bool
+	}{
+		{name: "keeps the neighbourhood clear", size: 5, bombs: 16, clearArea: true},
+		{name: "keeps only the cell clear on dense boards", size: 5, bombs: 24, clearArea: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			board := domain.NewEmptyBoard(tt.size, tt.size)
+			board.PlaceBombs(tt.bombs, 0, 0, rand.New(rand.NewSource(1)))
+
+			assert.Equal(t, tt.bombs, countMines(board))
+			assert.False(t, board.IsMine(0, 0))
+			assert.Equal(t, tt.clearArea, board.CountAdjacentBombs(0, 0) == 0)
+		})
+	}
+}
+
+func TestBoardPlaceBombsKeepsFlags(t *testing.T) {
+	board := domain.NewEmptyBoard(2, 2)
+	board.ToggleFlag(1, 1)
+
+	board.PlaceBombs(3, 0, 0, rand.New(rand.NewSource(1)))
+
+	assert.Equal(t, domain.Cell{Mine: true, Flagged: true, Adjacent: 2}, board.Cells[1][1])
+	assert.False(t, board.IsMine(0, 0))
+}
+
+func TestBoardUnmarshalLegacyCells(t *testing.T) {
+	board := domain.Board{}
+
+	err := json.Unmarshal([]byte(`[["X","1","-"],["FX","2","F"],["-","-","-"]]`), &board)
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]domain.Cell{
+		{{Mine: true, Adjacent: 1}, {Revealed: true, Adjacent: 2}, {}},
+		{{Mine: true, Flagged: true, Adjacent: 1}, {Revealed: true, Adjacent: 2}, {Flagged: true}},
+		{{Adjacent: 1}, {Adjacent: 1}, {}},
+	}, board.Cells)
+}
+
+func TestBoardJSONRoundTrip(t *testing.T) {
+	board := domain.NewBoard(6, 8, 12, rand.New(rand.NewSource(1)))
+	board.RevealArea(0, 0)
+	board.ToggleFlag(5, 7)
+
+	bytes, err := json.Marshal(board)
+	assert.Nil(t, err)
+
+	result := domain.Board{}
+	err = json.Unmarshal(bytes, &result)
+
+	assert.Nil(t, err)
+	assert.Equal(t, board, result)
+}
+
+func TestBoardMarshalPacksCells(t *testing.T) {
+	board := domain.NewEmptyBoard(1, 4)
+	board.SetMine(0, 0)
+	board.Explode(0, 0)
+	board.Reveal(0, 1)
+	board.ToggleFlag(0, 2)
+	board.CycleMark(0, 3)
+	board.CycleMark(0, 3)
+
+	bytes, err := json.Marshal(board)
+
+	assert.Nil(t, err)
+	assert.Equal(t, `[[272,33,64,128]]`, string(bytes))
+}
+
+func TestBoardUnmarshalKeepsStoredAdjacency(t *testing.T) {
+	// Counts worked out on a hex grid, where (1,1) does not touch (0,0).
+	board := domain.NewEmptyBoard(3, 3).WithTopology(domain.HexGrid{})
+	board.SetMine(0, 0)
+
+	bytes, err := json.Marshal(board)
+	assert.Nil(t, err)
+
+	result := domain.Board{}
+	err = json.Unmarshal(bytes, &result)
+
+	assert.Nil(t, err)
+	assert.Equal(t, board.Cells, result.Cells)
+	assert.Equal(t, uint8(0), result.Cells[1][1].Adjacent)
+}
+
+func TestBoardUnmarshalTypedCells(t *testing.T) {
+	board := domain.Board{}
+
+	err := json.Unmarshal([]byte(`[[{"mine":true,"revealed":false,"flagged":true,"questioned":false,"exploded":false,"adjacent":0},{"mine":false,"revealed":true,"flagged":false,"questioned":false,"exploded":false,"adjacent":1}]]`), &board)
+
+	assert.Nil(t, err)
+	assert.Equal(t, [][]domain.Cell{{{Mine: true, Flagged: true}, {Revealed: true, Adjacent: 1}}}, board.Cells)
+}
+
+func TestHexGridNeighbours(t *testing.T) {
+	grid := domain.HexGrid{}
+
+	tests := []struct {
+		name string
+		pos  domain.Position
+		want []domain.Position
+	}{
+		{name: "even row", pos: domain.Position{Row: 2, Col: 2}, want: []domain.Position{{Row: 1, Col: 1}, {Row: 1, Col: 2}, {Row: 2, Col: 1}, {Row: 2, Col: 3}, {Row: 3, Col: 1}, {Row: 3, Col: 2}}},
+		{name: "odd row", pos: domain.Position{Row: 1, Col: 2}, want: []domain.Position{{Row: 0, Col: 2}, {Row: 0, Col: 3}, {Row: 1, Col: 1}, {Row: 1, Col: 3}, {Row: 2, Col: 2}, {Row: 2, Col: 3}}},
+		{name: "corner", pos: domain.Position{Row: 0, Col: 0}, want: []domain.Position{{Row: 0, Col: 1}, {Row: 1, Col: 0}}},
+		{name: "odd row edge", pos: domain.Position{Row: 1, Col: 4}, want: []domain.Position{{Row: 0, Col: 4}, {Row: 1, Col: 3}, {Row: 2, Col: 4}}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, grid.Neighbours(5, 5, tt.pos.Row, tt.pos.Col), tt.name)
+	}
+}
+
+func TestBoardWithHexTopology(t *testing.T) {
+	// Odd rows are shifted half a cell to the right:
+	//  X - -
+	//   - - -
+	//  - - -
+	board := domain.NewEmptyBoard(3, 3).WithTopology(domain.HexGrid{})
+	board.SetMine(0, 0)
+
+	assert.Equal(t, uint(1), board.CountAdjacentBombs(0, 1))
+	assert.Equal(t, uint(1), board.CountAdjacentBombs(1, 0))
+	assert.Equal(t, uint(0), board.CountAdjacentBombs(1, 1))
+
+	board.RevealArea(2, 2)
+
+	assert.Equal(t, [][]string{{"-", "1", "0"}, {"1", "0", "0"}, {"0", "0", "0"}}, dto.BuildBoard(board))
+	assert.False(t, board.IsValidPosition(3, 0))
+	assert.Equal(t, []float64{0, 0.5, 0}, board.RowOffsets())
+}
+
+func TestTorusGridNeighbours(t *testing.T) {
+	grid := domain.TorusGrid{}
+
+	assert.Equal(t, []domain.Position{
+		{Row: 3, Col: 4}, {Row: 3, Col: 0}, {Row: 3, Col: 1},
+		{Row: 0, Col: 4}, {Row: 0, Col: 1},
+		{Row: 1, Col: 4}, {Row: 1, Col: 0}, {Row: 1, Col: 1},
+	}, grid.Neighbours(4, 5, 0, 0))
+	assert.Len(t, grid.Neighbours(4, 5, 2, 2), 8)
+	assert.Len(t, grid.Neighbours(2, 2, 0, 0), 3)
+}
+
+func TestBoardWithTorusTopology(t *testing.T) {
+	board := domain.NewEmptyBoard(4, 4).WithTopology(domain.TorusGrid{})
+	board.SetMine(0, 0)
+
+	assert.Equal(t, uint(1), board.CountAdjacentBombs(3, 3))
+	assert.Equal(t, uint(1), board.CountAdjacentBombs(0, 3))
+	assert.Equal(t, uint(0), board.CountAdjacentBombs(2, 2))
+
+	board.RevealArea(2, 2)
+
+	assert.Equal(t, [][]string{{"-", "1", "0", "1"}, {"1", "1", "0", "1"}, {"0", "0", "0", "0"}, {"1", "1", "0", "1"}}, dto.BuildBoard(board))
+	assert.Nil(t, board.RowOffsets())
+}
+
+func TestBoardPlaceBombsOnTorus(t *testing.T) {
+	board := domain.NewEmptyBoard(5, 5).WithTopology(domain.TorusGrid{})
+	board.PlaceBombs(16, 0, 0, rand.New(rand.NewSource(1)))
+
+	for _, pos := range append(board.Neighbours(0, 0), domain.Position{}) {
+		assert.False(t, board.IsMine(pos.Row, pos.Col))
+	}
+	assert.Equal(t, uint(16), countMines(board))
+}
+
+func TestTopologyOf(t *testing.T) {
+	square, ok := domain.TopologyOf("")
+	assert.True(t, ok)
+	assert.Equal(t, domain.SquareGrid{}, square)
+
+	hex, ok := domain.TopologyOf(domain.TopologyHex)
+	assert.True(t, ok)
+	assert.Equal(t, domain.HexGrid{}, hex)
+
+	torus, ok := domain.TopologyOf(domain.TopologyTorus)
+	assert.True(t, ok)
+	assert.Equal(t, domain.TorusGrid{}, torus)
+
+	_, ok = domain.TopologyOf("triangle")
+	assert.False(t, ok)
+}
+
 // ··· GAME TESTS ··· //
 
 func TestNewGame(t *testing.T) {
-	game := domain.NewGame("1001", "new game", 10, 50)
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 10, Cols: 10, Bombs: 50})
 
 	assert.Equal(t, "1001", game.ID)
 	assert.Equal(t, "new game", game.Name)
-	assert.Equal(t, domain.BoardSettings{Size: 10, Bombs: 50}, game.BoardSettings)
+	assert.Equal(t, uint(10), game.BoardSettings.Rows)
+	assert.Equal(t, uint(10), game.BoardSettings.Cols)
+	assert.Equal(t, uint(50), game.BoardSettings.Bombs)
+	assert.Nil(t, game.BoardSettings.Seed)
 	assert.Equal(t, domain.GameStateNew, game.State)
-	assert.Equal(t, 10, len(game.Board))
-	assert.Equal(t, 10, len(game.Board[0]))
+	assert.Equal(t, uint(10), game.Board.Rows())
+	assert.Equal(t, uint(10), game.Board.Cols())
+	assert.False(t, game.Seeded)
+	assert.Equal(t, domain.NewEmptyBoard(10, 10), game.Board)
+}
+
+func TestGame_Seed(t *testing.T) {
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 10, Cols: 10, Bombs: 50})
+
+	game.Seed(3, 4)
+
+	assert.True(t, game.Seeded)
+	assert.Equal(t, uint(0), game.Board.CountAdjacentBombs(3, 4))
+	assert.False(t, game.Board.IsMine(3, 4))
+}
+
+func TestBoardSettingsUnmarshalLegacySize(t *testing.T) {
+	game := domain.Game{}
+
+	err := json.Unmarshal([]byte(`{"id":"1001","board_settings":{"size":4,"bombs":3}}`), &game)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 3}, game.BoardSettings)
+}
+
+func TestBoardSettingsUnmarshal(t *testing.T) {
+	settings := domain.BoardSettings{}
+
+	err := json.Unmarshal([]byte(`{"rows":16,"cols":30,"bombs":99}`), &settings)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.BoardSettings{Rows: 16, Cols: 30, Bombs: 99}, settings)
+}
+
+func TestBoardSettingsMinedBoard(t *testing.T) {
+	settings := domain.BoardSettings{Rows: 3, Cols: 4, Topology: domain.TopologyTorus, Mines: []domain.Position{{Row: 0, Col: 0}, {Row: 2, Col: 3}}}
+
+	board := settings.MinedBoard()
+
+	assert.Equal(t, uint(3), board.Rows())
+	assert.Equal(t, uint(4), board.Cols())
+	assert.Equal(t, uint(2), countMines(board))
+	assert.True(t, board.IsMine(0, 0))
+	assert.True(t, board.IsMine(2, 3))
+	assert.Equal(t, uint8(2), board.Cells[2][0].Adjacent)
+}
+
+func TestNewGameSeed(t *testing.T) {
+	seed := int64(7)
+
+	seeded := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 10, Cols: 10, Bombs: 50, Seed: &seed})
+	unseeded := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 10, Cols: 10, Bombs: 50})
+
+	assert.Equal(t, int64(7), *seeded.BoardSettings.Seed)
+	assert.Nil(t, unseeded.BoardSettings.Seed, "seeds are drawn by the use cases, not the domain")
+}
+
+func TestGame_SeedIsReproducible(t *testing.T) {
+	seed := int64(2021)
+	settings := domain.BoardSettings{Rows: 16, Cols: 30, Bombs: 99, Seed: &seed}
+
+	game := domain.NewGame("1001", "first", settings)
+	sameSeed := domain.NewGame("1002", "second", settings)
+	otherSeed := domain.NewGame("1003", "third", domain.BoardSettings{Rows: 16, Cols: 30, Bombs: 99})
+
+	game.Seed(8, 15)
+	sameSeed.Seed(8, 15)
+	otherSeed.Seed(8, 15)
+
+	assert.Equal(t, game.Board, sameSeed.Board)
+	assert.NotEqual(t, game.Board, otherSeed.Board)
+}
+
+func TestBoardSettingsWithDifficulty(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings domain.BoardSettings
+		want     domain.BoardSettings
+		ok       bool
+	}{
+		{
+			name:     "beginner",
+			settings: domain.BoardSettings{Difficulty: domain.DifficultyBeginner},
+			want:     domain.BoardSettings{Rows: 9, Cols: 9, Bombs: 10, Difficulty: domain.DifficultyBeginner},
+			ok:       true,
+		},
+		{
+			name:     "intermediate",
+			settings: domain.BoardSettings{Difficulty: domain.DifficultyIntermediate},
+			want:     domain.BoardSettings{Rows: 16, Cols: 16, Bombs: 40, Difficulty: domain.DifficultyIntermediate},
+			ok:       true,
+		},
+		{
+			name:     "expert overrides the given dimensions",
+			settings: domain.BoardSettings{Rows: 5, Cols: 5, Bombs: 5, NoGuess: true, Difficulty: domain.DifficultyExpert},
+			want:     domain.BoardSettings{Rows: 16, Cols: 30, Bombs: 99, NoGuess: true, Difficulty: domain.DifficultyExpert},
+			ok:       true,
+		},
+		{
+			name:     "no difficulty is custom",
+			settings: domain.BoardSettings{Rows: 5, Cols: 6, Bombs: 7},
+			want:     domain.BoardSettings{Rows: 5, Cols: 6, Bombs: 7, Difficulty: domain.DifficultyCustom},
+			ok:       true,
+		},
+		{
+			name:     "unknown difficulty",
+			settings: domain.BoardSettings{Rows: 5, Cols: 6, Bombs: 7, Difficulty: "hard"},
+			want:     domain.BoardSettings{Rows: 5, Cols: 6, Bombs: 7, Difficulty: "hard"},
+			ok:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			settings, ok := tt.settings.WithDifficulty()
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, settings)
+		})
+	}
 }
 
 func TestGame_IsOver(t *testing.T) {
-	gameNew := domain.NewGame("1001", "new game", 10, 50)
+	gameNew := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 10, Cols: 10, Bombs: 50})
 
-	gameWon := domain.NewGame("1001", "won game", 10, 50)
+	gameWon := domain.NewGame("1001", "won game", domain.BoardSettings{Rows: 10, Cols: 10, Bombs: 50})
 	gameWon.State = domain.GameStateWon
 
-	gameLost := domain.NewGame("1001", "lost game", 10, 50)
+	gameLost := domain.NewGame("1001", "lost game", domain.BoardSettings{Rows: 10, Cols: 10, Bombs: 50})
 	gameLost.State = domain.GameStateLost
 
 	assert.False(t, gameNew.IsOver())
 	assert.True(t, gameWon.IsOver())
 	assert.True(t, gameLost.IsOver())
 }
+
+func countMines(board domain.Board) uint {
+	count := uint(0)
+
+	for i := range board.Cells {
+		for j := range board.Cells[i] {
+			if board.Cells[i][j].Mine {
+				count++
+			}
+		}
+	}
+
+	return count
+}
+
+func TestGame_VisibleBoard(t *testing.T) {
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 1})
+	game.Board.SetMine(0, 0)
+	game.Board.Reveal(2, 2)
+
+	assert.Equal(t, game.Board.HideBombs(), game.VisibleBoard())
+
+	game.Board.Explode(0, 0)
+	game.State = domain.GameStateLost
+	visible := game.VisibleBoard()
+
+	assert.Equal(t, game.Board, visible)
+	visible.ToggleFlag(1, 1)
+	assert.False(t, game.Board.IsFlagged(1, 1))
+}
+
+func TestGame_TrackTime(t *testing.T) {
+	start := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 1})
+
+	assert.Equal(t, time.Duration(0), game.ElapsedAt(start))
+
+	game.TrackTime(start)
+	game.TrackTime(start.Add(time.Second))
+
+	assert.Equal(t, start, *game.StartedAt)
+	assert.Nil(t, game.FinishedAt)
+	assert.Equal(t, 5*time.Second, game.ElapsedAt(start.Add(5*time.Second)))
+
+	game.State = domain.GameStateWon
+	game.TrackTime(start.Add(10 * time.Second))
+
+	assert.Equal(t, start.Add(10*time.Second), *game.FinishedAt)
+	assert.Equal(t, 10*time.Second, game.ElapsedAt(start.Add(time.Hour)))
+}
+
+func TestGame_ElapsedAtLeavesPausesOut(t *testing.T) {
+	start := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 1})
+	game.TrackTime(start)
+
+	game.PauseAt(start.Add(10 * time.Second))
+	game.ResumeAt(start.Add(40 * time.Second))
+	game.PauseAt(start.Add(50 * time.Second))
+
+	assert.Equal(t, 20*time.Second, game.ElapsedAt(start.Add(time.Minute)))
+	assert.Equal(t, 20*time.Second, game.ElapsedAt(start.Add(time.Hour)))
+
+	game.ResumeAt(start.Add(70 * time.Second))
+
+	assert.Equal(t, 25*time.Second, game.ElapsedAt(start.Add(75*time.Second)))
+
+	game.State = domain.GameStateWon
+	game.TrackTime(start.Add(80 * time.Second))
+
+	assert.Equal(t, 30*time.Second, game.ElapsedAt(start.Add(time.Hour)))
+}
+
+func TestGame_VisibleBoardWhilePaused(t *testing.T) {
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 1})
+	game.Board.SetMine(0, 0)
+	game.Board.Reveal(2, 2)
+	game.State = domain.GameStatePaused
+
+	assert.Equal(t, game.Board.HideBombs(), game.VisibleBoard())
+
+	game.BoardSettings.MaskPaused = true
+
+	assert.Equal(t, game.BoardSettings.EmptyBoard(), game.VisibleBoard())
+
+	game.State = domain.GameStateInProgress
+
+	assert.Equal(t, game.Board.HideBombs(), game.VisibleBoard())
+}
+
+func TestGame_Restart(t *testing.T) {
+	start := domain.Position{Row: 2, Col: 2}
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 1, Start: &start})
+	game.Board.SetMine(0, 0)
+	game.Seeded = true
+	game.Board.ToggleFlag(0, 0)
+	game.Board.Reveal(0, 1)
+	game.State = domain.GameStateWon
+	game.Moves = []domain.Move{{Action: domain.MoveReveal}}
+
+	restarted := game.Restart()
+
+	assert.Equal(t, domain.GameStateNew, restarted.State)
+	assert.True(t, restarted.Seeded)
+	assert.Empty(t, restarted.Moves)
+	assert.Equal(t, [][]string{{"-", "1", "0"}, {"1", "1", "0"}, {"0", "0", "0"}}, dto.BuildBoard(restarted.Board))
+	assert.True(t, restarted.Board.IsMine(0, 0))
+	assert.True(t, game.Board.IsFlagged(0, 0))
+}
+
+func TestGame_Detonate(t *testing.T) {
+	game := domain.NewGame("1001", "lives", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 2, Lives: 2})
+	game.Board.SetMine(0, 0)
+	game.Board.SetMine(2, 2)
+	game.Seeded = true
+	game.State = domain.GameStateInProgress
+
+	assert.Equal(t, uint(2), game.LivesLeft())
+	assert.True(t, game.MultiLife())
+
+	game.Detonate(0, 0)
+
+	assert.Equal(t, uint(1), game.LivesLeft())
+	assert.Equal(t, domain.GameStateInProgress, game.State)
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 0}}, game.Detonations)
+	assert.Equal(t, domain.Cell{Mine: true, Exploded: true}, game.VisibleBoard().Cells[0][0])
+	assert.Equal(t, uint(1), game.Board.CountAdjacentFlags(1, 1))
+	assert.Len(t, game.Board.HiddenNeighbours(1, 1), 7)
+
+	game.Detonate(2, 2)
+
+	assert.Equal(t, uint(0), game.LivesLeft())
+	assert.Equal(t, domain.GameStateLost, game.State)
+}
+
+func TestGame_LivesLeftDefault(t *testing.T) {
+	game := domain.NewGame("1001", "classic", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 1})
+
+	assert.Equal(t, uint(1), game.LivesLeft())
+	assert.False(t, game.MultiLife())
+}
+
+func TestBoardCycleMark(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.Reveal(1, 1)
+
+	board.CycleMark(0, 0)
+	assert.True(t, board.IsFlagged(0, 0))
+	assert.False(t, board.IsQuestioned(0, 0))
+
+	board.CycleMark(0, 0)
+	assert.False(t, board.IsFlagged(0, 0))
+	assert.True(t, board.IsQuestioned(0, 0))
+	assert.Equal(t, uint(0), board.CountAdjacentFlags(1, 1))
+	assert.Len(t, board.HiddenNeighbours(1, 1), 8)
+
+	board.CycleMark(0, 0)
+	assert.False(t, board.IsFlagged(0, 0))
+	assert.False(t, board.IsQuestioned(0, 0))
+
+	board.CycleMark(1, 1)
+	assert.Equal(t, domain.Cell{Revealed: true, Adjacent: 1}, board.Cells[1][1])
+}
+
+func TestBoardRevealAreaOpensQuestionMarks(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.CycleMark(2, 1)
+	board.CycleMark(2, 1)
+
+	board.RevealArea(2, 2)
+
+	assert.Equal(t, domain.Cell{Revealed: true}, board.Cells[2][1])
+}
+
+func TestGame_MinesLeft(t *testing.T) {
+	game := domain.NewGame("1001", "marks", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 3, Lives: 2})
+	game.Board.SetMine(0, 0)
+	game.Board.SetMine(0, 1)
+	game.Board.SetMine(0, 2)
+	game.Board.ToggleFlag(0, 1)
+	game.Board.CycleMark(2, 2)
+	game.Board.CycleMark(2, 2)
+	game.Detonate(0, 2)
+
+	assert.Equal(t, 1, game.MinesLeft())
+}
+
+func TestBoardThreeBV(t *testing.T) {
+	tests := []struct {
+		name  string
+		rows  uint
+		cols  uint
+		bombs []domain.Position
+		want  uint
+	}{
+		{name: "single opening", rows: 3, cols: 3, bombs: []domain.Position{{Row: 0, Col: 0}}, want: 1},
+		{name: "two openings", rows: 3, cols: 3, bombs: []domain.Position{{Row: 0, Col: 0}, {Row: 2, Col: 2}}, want: 2},
+		{name: "numbers only", rows: 1, cols: 3, bombs: []domain.Position{{Row: 0, Col: 1}}, want: 2},
+		{name: "opening and isolated number", rows: 1, cols: 5, bombs: []domain.Position{{Row: 0, Col: 1}, {Row: 0, Col: 3}}, want: 3},
+		{name: "no bombs", rows: 4, cols: 4, want: 1},
+	}
+
+	for _, tt := range tests {
+		board := domain.NewEmptyBoard(tt.rows, tt.cols)
+		for _, bomb := range tt.bombs {
+			board.SetMine(bomb.Row, bomb.Col)
+		}
+		board.Reveal(tt.rows-1, tt.cols-1)
+
+		assert.Equal(t, tt.want, board.ThreeBV(), tt.name)
+	}
+}
+
+func TestGame_SeedWorksOutThreeBV(t *testing.T) {
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 9, Cols: 9, Bombs: 10})
+	game.Seed(4, 4)
+
+	assert.NotZero(t, game.ThreeBV)
+	assert.Equal(t, game.Board.ThreeBV(), game.ThreeBV)
+}
+
+func TestGame_OpenStartLeavesItsOpeningOutOfThreeBV(t *testing.T) {
+	// Two openings: the player gets the top right one for free.
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 2})
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.SetMine(2, 2)
+	game.SetLayout(board)
+
+	assert.Equal(t, uint(2), game.ThreeBV)
+
+	game.OpenStart(domain.Position{Row: 0, Col: 2})
+
+	assert.Equal(t, uint(1), game.ThreeBV)
+	assert.Equal(t, &domain.Position{Row: 0, Col: 2}, game.BoardSettings.Start)
+	assert.True(t, game.Board.IsRevealed(1, 1))
+	assert.Equal(t, uint(2), game.Board.ThreeBV())
+}
+
+func TestGame_ScoreWin(t *testing.T) {
+	started := time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+	finished := started.Add(4 * time.Second)
+	game := domain.NewGame("1001", "new game", domain.BoardSettings{Rows: 3, Cols: 3, Bombs: 1})
+	game.ThreeBV = 6
+	game.Moves = make([]domain.Move, 8)
+	game.State = domain.GameStateWon
+	game.StartedAt = &started
+	game.FinishedAt = &finished
+
+	game.ScoreWin()
+
+	assert.Equal(t, &domain.Score{ThreeBV: 6, Clicks: 8, Efficiency: 0.75, ThreeBVPerSecond: 1.5}, game.Score)
+}
+
+func TestGame_Transition(t *testing.T) {
+	tests := []struct {
+		state string
+		event string
+		want  string
+		ok    bool
+	}{
+		{state: domain.GameStateNew, event: domain.GameEventPlay, want: domain.GameStateInProgress, ok: true},
+		{state: domain.GameStateInProgress, event: domain.GameEventPlay, want: domain.GameStateInProgress, ok: true},
+		{state: domain.GameStateInProgress, event: domain.GameEventWin, want: domain.GameStateWon, ok: true},
+		{state: domain.GameStateInProgress, event: domain.GameEventLose, want: domain.GameStateLost, ok: true},
+		{state: domain.GameStateNew, event: domain.GameEventAbandon, want: domain.GameStateAbandoned, ok: true},
+		{state: domain.GameStateLost, event: domain.GameEventRewind, want: domain.GameStateNew, ok: true},
+		{state: domain.GameStateAbandoned, event: domain.GameEventReview, want: domain.GameStateAbandoned, ok: true},
+		{state: domain.GameStateNew, event: domain.GameEventWin, want: domain.GameStateNew, ok: false},
+		{state: domain.GameStateWon, event: domain.GameEventPlay, want: domain.GameStateWon, ok: false},
+		{state: domain.GameStateLost, event: domain.GameEventAbandon, want: domain.GameStateLost, ok: false},
+		{state: domain.GameStateAbandoned, event: domain.GameEventRewind, want: domain.GameStateAbandoned, ok: false},
+		{state: domain.GameStateInProgress, event: domain.GameEventReview, want: domain.GameStateInProgress, ok: false},
+		{state: domain.GameStateInProgress, event: domain.GameEventPause, want: domain.GameStatePaused, ok: true},
+		{state: domain.GameStatePaused, event: domain.GameEventResume, want: domain.GameStateInProgress, ok: true},
+		{state: domain.GameStatePaused, event: domain.GameEventAbandon, want: domain.GameStateAbandoned, ok: true},
+		{state: domain.GameStatePaused, event: domain.GameEventPlay, want: domain.GameStatePaused, ok: false},
+		{state: domain.GameStatePaused, event: domain.GameEventAssist, want: domain.GameStatePaused, ok: false},
+		{state: domain.GameStateNew, event: domain.GameEventPause, want: domain.GameStateNew, ok: false},
+	}
+
+	for _, tt := range tests {
+		game := domain.Game{State: tt.state}
+
+		assert.Equal(t, tt.ok, game.Can(tt.event), tt.state+" "+tt.event)
+		assert.Equal(t, tt.ok, game.Transition(tt.event), tt.state+" "+tt.event)
+		assert.Equal(t, tt.want, game.State, tt.state+" "+tt.event)
+	}
+}
+
+func TestChallengeID(t *testing.T) {
+	assert.Equal(t, "2021-10-01", domain.ChallengeID(time.Date(2021, 10, 1, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, "2021-10-02", domain.ChallengeID(time.Date(2021, 10, 1, 23, 0, 0, 0, time.FixedZone("UTC-2", -2*60*60))))
+}
+
+func TestChallengeSeed(t *testing.T) {
+	assert.Equal(t, domain.ChallengeSeed("secret", "2021-10-01"), domain.ChallengeSeed("secret", "2021-10-01"))
+	assert.NotEqual(t, domain.ChallengeSeed("secret", "2021-10-01"), domain.ChallengeSeed("secret", "2021-10-02"))
+	assert.NotEqual(t, domain.ChallengeSeed("secret", "2021-10-01"), domain.ChallengeSeed("other", "2021-10-01"))
+}
+
+func TestChallenge_GameOf(t *testing.T) {
+	challenge := domain.Challenge{ID: "2021-10-01", Entries: []domain.ChallengeEntry{{Player: "alice", GameID: "1001"}, {Player: "bob", GameID: "1002"}}}
+
+	gameID, ok := challenge.GameOf("bob")
+	assert.True(t, ok)
+	assert.Equal(t, "1002", gameID)
+
+	_, ok = challenge.GameOf("carol")
+	assert.False(t, ok)
+}
+
+func TestGameUnmarshalLegacyStartedGame(t *testing.T) {
+	game := domain.Game{}
+
+	err := json.Unmarshal([]byte(`{"id":"1001","state":"new","board_settings":{"rows":1,"cols":2,"bombs":1},"board":[["X","-"]],"seeded":true,"started_at":"2021-10-01T12:00:00Z"}`), &game)
+
+	assert.Nil(t, err)
+	assert.Equal(t, domain.GameStateInProgress, game.State)
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"hexagonal/src/core/domain"
+	"hexagonal/src/core/dto"
+	"testing"
+	"time"
+)
+
+func TestBuildResponseGame(t *testing.T) {
+	seed := int64(42)
+	game := domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 2, Cols: 3, Bombs: 1, Seed: &seed})
+	game.Board.SetMine(0, 0)
+	game.Board.ToggleFlag(0, 0)
+	game.Board.Reveal(1, 1)
+	game.Board.Reveal(1, 2)
+	game.Seeded = true
+	game.Board = game.Board.HideBombs()
+
+	bytes, err := json.Marshal(dto.BuildResponseGame(game))
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"id": "1001",
+		"name": "mygame",
+		"state": "new",
+		"board_settings": {"rows": 2, "cols": 3, "bombs": 1},
+		"board": [["F", "-", "-"], ["-", "1", "0"]],
+		"seeded": true,
+		"elapsed_seconds": 0,
+		"assisted": false,
+		"multi_life": false,
+		"lives": 1,
+		"mines_left": 0
+	}`, string(bytes))
+}
+
+func TestBuildResponseGameOnHexGrid(t *testing.T) {
+	game := domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 3, Cols: 2, Bombs: 1, Topology: domain.TopologyHex})
+	game.Board = game.VisibleBoard()
+
+	bytes, err := json.Marshal(dto.BuildResponseGame(game))
+
+	assert.Nil(t, err)
+	assert.Contains(t, string(bytes), `"row_offsets":[0,0.5,0]`)
+}
+
+func TestBuildResponseGameOver(t *testing.T) {
+	game := domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 2, Cols: 3, Bombs: 3})
+	game.Board.SetMine(0, 0)
+	game.Board.SetMine(0, 1)
+	game.Board.SetMine(0, 2)
+	game.Board.ToggleFlag(0, 0)
+	game.Board.ToggleFlag(1, 0)
+	game.Board.Reveal(1, 2)
+	game.Board.Explode(0, 1)
+	game.State = domain.GameStateLost
+	game.ThreeBV = 1
+
+	response := dto.BuildResponseGame(game)
+
+	assert.Equal(t, [][]string{{"F", "*", "X"}, {"!", "-", "2"}}, response.Board)
+	assert.Equal(t, uint(1), response.ThreeBV)
+}
+
+func TestBuildResponseGameHidesThreeBVWhilePlaying(t *testing.T) {
+	game := domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 2, Cols: 3, Bombs: 1})
+	game.Board.SetMine(0, 0)
+	game.ThreeBV = game.Board.ThreeBV()
+	game.State = domain.GameStateInProgress
+
+	assert.Zero(t, dto.BuildResponseGame(game).ThreeBV)
+
+	game.State = domain.GameStateWon
+
+	assert.Equal(t, game.ThreeBV, dto.BuildResponseGame(game).ThreeBV)
+}
+
+func TestBuildResponseGameWithLives(t *testing.T) {
+	game := domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 2, Cols: 2, Bombs: 2, Lives: 3})
+	game.Board.SetMine(0, 0)
+	game.Board.SetMine(1, 1)
+	game.Detonate(0, 0)
+	game.Board.Reveal(0, 1)
+	game.Board = game.VisibleBoard()
+
+	response := dto.BuildResponseGame(game)
+
+	assert.Equal(t, [][]string{{"*", "2"}, {"-", "-"}}, response.Board)
+	assert.Equal(t, uint(2), response.Lives)
+	assert.True(t, response.MultiLife)
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 0}}, response.Detonations)
+}
+
+func TestBuildBoardQuestionMarks(t *testing.T) {
+	board := domain.NewEmptyBoard(1, 3)
+	board.SetMine(0, 0)
+	board.CycleMark(0, 0)
+	board.CycleMark(0, 0)
+	board.CycleMark(0, 1)
+	board.CycleMark(0, 1)
+
+	assert.Equal(t, [][]string{{"?", "?", "-"}}, dto.BuildBoard(board.HideBombs()))
+	assert.Equal(t, [][]string{{"X", "?", "-"}}, dto.BuildDisclosedBoard(board))
+}
+
+func TestBuildResponseDailyResults(t *testing.T) {
+	results := []domain.ChallengeResult{
+		{Player: "carol", GameID: "1003", State: domain.GameStateWon, Elapsed: 61500 * time.Millisecond, MultiLife: true, Score: &domain.Score{ThreeBV: 30, Clicks: 40, Efficiency: 0.75, ThreeBVPerSecond: 0.5}},
+		{Player: "alice", GameID: "1001", State: domain.GameStateLost, Elapsed: 30 * time.Second, Assisted: true},
+	}
+
+	bytes, err := json.Marshal(dto.BuildResponseDailyResults("2021-10-01", results))
+
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{
+		"challenge": "2021-10-01",
+		"results": [
+			{"player": "carol", "game_id": "1003", "state": "won", "elapsed_seconds": 61.5, "assisted": false, "multi_life": true,
+				"score": {"3bv": 30, "clicks": 40, "efficiency": 0.75, "3bv_per_second": 0.5}},
+			{"player": "alice", "game_id": "1001", "state": "lost", "elapsed_seconds": 30, "assisted": true, "multi_life": false}
+		]
+	}`, string(bytes))
+}
+
+func TestBuildResponseGameHidesListedMines(t *testing.T) {
+	game := domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 2, Cols: 2, Bombs: 1, Mines: []domain.Position{{Row: 1, Col: 1}}})
+	game.SetLayout(game.BoardSettings.MinedBoard())
+	game.Board = game.VisibleBoard()
+
+	bytes, err := json.Marshal(dto.BuildResponseGame(game))
+
+	assert.Nil(t, err)
+	assert.NotContains(t, string(bytes), `"mines"`)
+	assert.NotContains(t, string(bytes), `"seed"`)
+}
@@ -0,0 +1,44 @@
+package mockups
+
+import (
+	"github.com/golang/mock/gomock"
+	"reflect"
+)
+
+// MockRandom is a mock of RandomPort interface
+type MockRandom struct {
+	ctrl     *gomock.Controller
+	recorder *MockRandomMockRecorder
+}
+
+// MockRandomMockRecorder is the mock recorder for MockRandom
+type MockRandomMockRecorder struct {
+	mock *MockRandom
+}
+
+// NewMockRandom creates a new mock instance
+func NewMockRandom(ctrl *gomock.Controller) *MockRandom {
+	mock := &MockRandom{ctrl: ctrl}
+	mock.recorder = &MockRandomMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockRandom) EXPECT() *MockRandomMockRecorder {
+	return m.recorder
+}
+
+// Seed mocks base method
+func (m *MockRandom) Seed() (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Seed")
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Seed indicates an expected call of Seed
+func (mr *MockRandomMockRecorder) Seed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seed", reflect.TypeOf((*MockRandom)(nil).Seed))
+}
@@ -57,3 +57,55 @@ func (mr *MockGamesRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockGamesRepository)(nil).Save), arg0)
 }
+
+// MockChallengesRepository is a mock of ChallengesRepository interface
+type MockChallengesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChallengesRepositoryMockRecorder
+}
+
+// MockChallengesRepositoryMockRecorder is the mock recorder for MockChallengesRepository
+type MockChallengesRepositoryMockRecorder struct {
+	mock *MockChallengesRepository
+}
+
+// NewMockChallengesRepository creates a new mock instance
+func NewMockChallengesRepository(ctrl *gomock.Controller) *MockChallengesRepository {
+	mock := &MockChallengesRepository{ctrl: ctrl}
+	mock.recorder = &MockChallengesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockChallengesRepository) EXPECT() *MockChallengesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockChallengesRepository) Get(id string) (domain.Challenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(domain.Challenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockChallengesRepositoryMockRecorder) Get(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChallengesRepository)(nil).Get), id)
+}
+
+// Save mocks base method
+func (m *MockChallengesRepository) Save(arg0 domain.Challenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save
+func (mr *MockChallengesRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockChallengesRepository)(nil).Save), arg0)
+}
@@ -0,0 +1,19 @@
+package tests
+
+import (
+	"github.com/stretchr/testify/assert"
+	"hexagonal/src/adapters/random"
+	"testing"
+)
+
+func TestCryptoRandomSeed(t *testing.T) {
+	source := random.NewCryptoRandom()
+
+	seed, err := source.Seed()
+	assert.Nil(t, err)
+
+	seedAgain, err := source.Seed()
+	assert.Nil(t, err)
+
+	assert.NotEqual(t, seed, seedAgain)
+}
@@ -0,0 +1,89 @@
+package tests
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/assert"
+	"hexagonal/src/adapters/repositories/memory_kvs"
+	"hexagonal/src/core/domain"
+	"testing"
+)
+
+func TestMemoryKVSKeepsSeededState(t *testing.T) {
+	repository := memory_kvs.NewMemKVS()
+
+	unseeded := domain.NewGame("1001", "unseeded", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 3})
+	seeded := domain.NewGame("1002", "seeded", domain.BoardSettings{Rows: 3, Cols: 5, Bombs: 3})
+	seeded.Seed(0, 0)
+
+	assert.Nil(t, repository.Save(unseeded))
+	assert.Nil(t, repository.Save(seeded))
+
+	unseededResult, err := repository.Get("1001")
+	assert.Nil(t, err)
+	assert.Equal(t, unseeded, unseededResult)
+
+	seededResult, err := repository.Get("1002")
+	assert.Nil(t, err)
+	assert.Equal(t, seeded, seededResult)
+}
+
+func TestMemoryKVSKeepsLegacyGameSeeded(t *testing.T) {
+	repository := memory_kvs.NewMemKVS()
+
+	// Stored before bombs were placed on the first reveal: they were laid when the game was created.
+	legacy := domain.Game{}
+	err := json.Unmarshal([]byte(`{"id":"1001","name":"legacy","state":"new","board_settings":{"size":3,"bombs":2},"board":[["X","-","-"],["-","X","-"],["-","-","-"]]}`), &legacy)
+	assert.Nil(t, err)
+
+	assert.Nil(t, repository.Save(legacy))
+
+	result, err := repository.Get("1001")
+	assert.Nil(t, err)
+	assert.True(t, result.Seeded)
+	assert.Equal(t, legacy, result)
+	assert.Equal(t, uint(2), countMines(result.Board))
+	assert.Equal(t, uint(2), result.Board.CountAdjacentBombs(0, 1))
+	assert.NotZero(t, result.ThreeBV)
+}
+
+func TestMemoryKVSKeepsTopology(t *testing.T) {
+	repository := memory_kvs.NewMemKVS()
+
+	game := domain.NewGame("1001", "hex", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 3, Topology: domain.TopologyHex})
+	game.Seed(0, 0)
+
+	assert.Nil(t, repository.Save(game))
+
+	result, err := repository.Get("1001")
+	assert.Nil(t, err)
+	assert.Equal(t, game, result)
+	assert.Equal(t, domain.HexGrid{}, result.Board.Topology)
+}
+
+func TestMemoryKVSKeepsWrappedBoard(t *testing.T) {
+	repository := memory_kvs.NewMemKVS()
+
+	game := domain.NewGame("1001", "torus", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 3, Topology: domain.TopologyTorus})
+	game.Seed(0, 0)
+
+	assert.Nil(t, repository.Save(game))
+
+	result, err := repository.Get("1001")
+	assert.Nil(t, err)
+	assert.Equal(t, game, result)
+}
+
+func TestChallengeMemoryKVS(t *testing.T) {
+	repository := memory_kvs.NewChallengeMemKVS()
+
+	challenge := domain.Challenge{ID: "2021-10-01", Entries: []domain.ChallengeEntry{{Player: "alice", GameID: "1001"}}}
+
+	_, err := repository.Get("2021-10-01")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, repository.Save(challenge))
+
+	result, err := repository.Get("2021-10-01")
+	assert.Nil(t, err)
+	assert.Equal(t, challenge, result)
+}
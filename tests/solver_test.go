@@ -0,0 +1,236 @@
+package tests
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"hexagonal/src/core/domain"
+	"hexagonal/src/core/solver"
+	"hexagonal/tests/mocks/mockups"
+	"testing"
+	"time"
+)
+
+func TestAnalyzeSingleCellRule(t *testing.T) {
+	// X - -
+	// 1 1 0
+	board := domain.NewEmptyBoard(2, 3)
+	board.SetMine(0, 0)
+	board.Reveal(1, 0)
+	board.Reveal(1, 1)
+	board.Reveal(1, 2)
+
+	result := solver.Analyze(board.HideBombs(), solver.Deadline{})
+
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 1}, {Row: 0, Col: 2}}, result.Safe)
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 0}}, result.Mines)
+}
+
+func TestAnalyzeSubsetRule(t *testing.T) {
+	// X - - X
+	// 1 1 1 1
+	board := domain.NewEmptyBoard(2, 4)
+	board.SetMine(0, 0)
+	board.SetMine(0, 3)
+	for col := uint(0); col < 4; col++ {
+		board.Reveal(1, col)
+	}
+	board.ToggleFlag(0, 1)
+
+	result := solver.Analyze(board.HideBombs(), solver.Deadline{})
+
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 1}, {Row: 0, Col: 2}}, result.Safe)
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 0}, {Row: 0, Col: 3}}, result.Mines)
+}
+
+func TestAnalyzeNothingCertain(t *testing.T) {
+	board := domain.NewEmptyBoard(2, 2)
+	board.SetMine(0, 0)
+	board.Reveal(1, 1)
+
+	result := solver.Analyze(board.HideBombs(), solver.Deadline{})
+
+	assert.Empty(t, result.Safe)
+	assert.Empty(t, result.Mines)
+}
+
+func TestIsSolvable(t *testing.T) {
+	solvable := domain.NewEmptyBoard(3, 3)
+	solvable.SetMine(0, 0)
+
+	fiftyFifty := domain.NewEmptyBoard(2, 2)
+	fiftyFifty.SetMine(0, 0)
+
+	assert.True(t, solver.IsSolvable(solvable, 2, 2, solver.Deadline{}))
+	assert.False(t, solver.IsSolvable(fiftyFifty, 1, 1, solver.Deadline{}))
+	assert.False(t, solver.IsSolvable(solvable, 0, 0, solver.Deadline{}))
+	assert.False(t, solvable.IsRevealed(2, 2))
+}
+
+func TestIsSolvableGivesUpAtTheDeadline(t *testing.T) {
+	clock := mockups.NewMockClock(gomock.NewController(t))
+	clock.EXPECT().Now().Return(testNow).AnyTimes()
+
+	// Opening the bottom-left corner leaves the top corners to be worked out
+	solvable := domain.NewEmptyBoard(3, 3)
+	solvable.SetMine(0, 1)
+
+	assert.True(t, solver.IsSolvable(solvable, 2, 0, solver.NewDeadline(clock, time.Second)))
+	assert.False(t, solver.IsSolvable(solvable, 2, 0, solver.NewDeadline(clock, 0)))
+}
+
+func TestAnalyzeStopsAtTheDeadline(t *testing.T) {
+	clock := mockups.NewMockClock(gomock.NewController(t))
+	clock.EXPECT().Now().Return(testNow).AnyTimes()
+
+	board := domain.NewEmptyBoard(3, 3)
+	board.SetMine(0, 0)
+	board.Reveal(1, 1)
+	board.Reveal(0, 1)
+	board.Reveal(1, 0)
+
+	assert.NotEmpty(t, solver.Analyze(board.HideBombs(), solver.NewDeadline(clock, time.Second)).Mines)
+	assert.Empty(t, solver.Analyze(board.HideBombs(), solver.NewDeadline(clock, 0)).Mines)
+}
+
+func TestProbabilitiesExact(t *testing.T) {
+	// - -
+	// - 1
+	board := domain.NewEmptyBoard(2, 2)
+	board.SetMine(0, 0)
+	board.Reveal(1, 1)
+
+	heatmap := solver.Probabilities(board.HideBombs(), 1, solver.Deadline{})
+
+	assert.True(t, heatmap.Exact)
+	assert.Len(t, heatmap.Cells, 3)
+	for _, cell := range heatmap.Cells {
+		assert.InDelta(t, 1.0/3, cell.Mine, 1e-9)
+	}
+}
+
+func TestProbabilitiesInterior(t *testing.T) {
+	// 1 - - - -
+	board := domain.NewEmptyBoard(1, 5)
+	board.SetMine(0, 1)
+	board.SetMine(0, 3)
+	board.Reveal(0, 0)
+
+	heatmap := solver.Probabilities(board.HideBombs(), 2, solver.Deadline{})
+
+	assert.True(t, heatmap.Exact)
+	assert.Equal(t, []domain.Probability{
+		{Row: 0, Col: 1, Mine: 1},
+		{Row: 0, Col: 2, Mine: 1.0 / 3},
+		{Row: 0, Col: 3, Mine: 1.0 / 3},
+		{Row: 0, Col: 4, Mine: 1.0 / 3},
+	}, heatmap.Cells)
+}
+
+func TestProbabilitiesIgnoresFlags(t *testing.T) {
+	board := domain.NewEmptyBoard(2, 2)
+	board.SetMine(0, 0)
+	board.Reveal(1, 1)
+	board.ToggleFlag(0, 1)
+
+	heatmap := solver.Probabilities(board.HideBombs(), 1, solver.Deadline{})
+
+	assert.InDelta(t, 1.0/3, heatmap.Cells[1].Mine, 1e-9)
+}
+
+func TestProbabilitiesLargeFrontier(t *testing.T) {
+	board := domain.NewEmptyBoard(2, 30)
+	for col := uint(0); col < 30; col += 2 {
+		board.SetMine(0, col)
+	}
+	for col := uint(0); col < 30; col++ {
+		board.Reveal(1, col)
+	}
+
+	heatmap := solver.Probabilities(board.HideBombs(), 15, solver.Deadline{})
+
+	assert.False(t, heatmap.Exact)
+	assert.Len(t, heatmap.Cells, 30)
+	for _, cell := range heatmap.Cells {
+		assert.True(t, cell.Mine >= 0 && cell.Mine <= 1)
+	}
+}
+
+func TestProbabilitiesDeadline(t *testing.T) {
+	board := domain.NewEmptyBoard(3, 24)
+	for col := uint(0); col < 24; col += 2 {
+		board.SetMine(1, col)
+	}
+	for col := uint(0); col < 24; col++ {
+		board.Reveal(2, col)
+	}
+
+	clock := mockups.NewMockClock(gomock.NewController(t))
+	clock.EXPECT().Now().Return(testNow).AnyTimes()
+
+	heatmap := solver.Probabilities(board.HideBombs(), 12, solver.NewDeadline(clock, 0))
+
+	assert.False(t, heatmap.Exact)
+	assert.Len(t, heatmap.Cells, 48)
+}
+
+func TestProbabilitiesDeadlineWhileCombining(t *testing.T) {
+	// Three groups far from each other, each enumerated in one go
+	board := domain.NewEmptyBoard(2, 10)
+	board.SetMine(0, 1)
+	board.SetMine(0, 4)
+	board.SetMine(0, 8)
+	board.Reveal(1, 0)
+	board.Reveal(1, 4)
+	board.Reveal(1, 8)
+
+	clock := mockups.NewMockClock(gomock.NewController(t))
+	clock.EXPECT().Now().Return(testNow).AnyTimes()
+	exact := solver.Probabilities(board.HideBombs(), 3, solver.NewDeadline(clock, time.Second))
+
+	// The deadline is read once when set and once per group enumerated, and is over when combining
+	expiring := mockups.NewMockClock(gomock.NewController(t))
+	first := expiring.EXPECT().Now().Return(testNow).Times(4)
+	expiring.EXPECT().Now().Return(testNow.Add(time.Second)).After(first).AnyTimes()
+	estimated := solver.Probabilities(board.HideBombs(), 3, solver.NewDeadline(expiring, time.Second))
+
+	assert.True(t, exact.Exact)
+	assert.False(t, estimated.Exact)
+	assert.Equal(t, len(exact.Cells), len(estimated.Cells))
+	assert.Equal(t, domain.Probability{Row: 0, Col: 0, Mine: 1.0 / 3}, estimated.Cells[0])
+}
+
+func TestProbabilitiesManyGroups(t *testing.T) {
+	// A 1 every three columns on every third row makes hundreds of groups
+	board := domain.NewEmptyBoard(60, 60)
+	for row := uint(0); row < 60; row += 3 {
+		for col := uint(0); col < 60; col += 3 {
+			board.SetMine(row, col)
+			board.Reveal(row+1, col+1)
+		}
+	}
+
+	start := time.Now()
+	heatmap := solver.Probabilities(board.HideBombs(), 400, solver.Deadline{})
+
+	assert.True(t, heatmap.Exact)
+	assert.Less(t, int64(time.Since(start)), int64(time.Second))
+	for _, cell := range heatmap.Cells {
+		if cell.Row == 0 && cell.Col == 0 {
+			assert.InDelta(t, 1.0/8, cell.Mine, 1e-9)
+		}
+	}
+}
+
+func TestAnalyzeKnowsBombsSetOff(t *testing.T) {
+	board := domain.NewEmptyBoard(2, 2)
+	board.SetMine(0, 0)
+	board.Explode(0, 0)
+	board.Reveal(1, 1)
+
+	result := solver.Analyze(board.HideBombs(), solver.Deadline{})
+	heatmap := solver.Probabilities(board.HideBombs(), 1, solver.Deadline{})
+
+	assert.Equal(t, []domain.Position{{Row: 0, Col: 1}, {Row: 1, Col: 0}}, result.Safe)
+	assert.Empty(t, result.Mines)
+	assert.Equal(t, []domain.Probability{{Row: 0, Col: 1, Mine: 0}, {Row: 1, Col: 0, Mine: 0}}, heatmap.Cells)
+}
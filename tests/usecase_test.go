@@ -4,18 +4,32 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/matiasvarela/errors"
 	"github.com/stretchr/testify/assert"
+	"hexagonal/src/adapters/repositories/memory_kvs"
 	"hexagonal/src/config/apperrors"
 	"hexagonal/src/core/domain"
+	"hexagonal/src/core/dto"
+	"hexagonal/src/core/solver"
 	"hexagonal/src/core/usecases"
 	"hexagonal/tests/mocks/mockups"
 	"testing"
+	"time"
 )
 
 type mocks struct {
-	gameRepository *mockups.MockGamesRepository
-	uidGen         *mockups.MockUIDGen
+	gameRepository      *mockups.MockGamesRepository
+	challengeRepository *mockups.MockChallengesRepository
+	uidGen              *mockups.MockUIDGen
+	clock               *mockups.MockClock
+	random              *mockups.MockRandom
 }
 
+var testNow = time.Date(2021, 10, 1, 12, 0, 0, 0, time.UTC)
+
+const (
+	testSecret = "s3cr3t"
+	testSeed   = int64(1001)
+)
+
 func TestGet(t *testing.T) {
 	// · Mocks · //
 
@@ -35,6 +49,14 @@ func TestGet(t *testing.T) {
 		true,
 		[]pos{{1, 1}},
 		[]pos{})
+	finishedGame := withExploded(withFlags(easymockGame(
+		"1001",
+		"mygame",
+		4,
+		domain.GameStateLost,
+		false,
+		[]pos{{1, 1}, {2, 2}},
+		[]pos{{0, 0}}), []pos{{3, 3}}), pos{2, 2})
 
 	// · Tests · //
 	type args struct {
@@ -60,6 +82,22 @@ func TestGet(t *testing.T) {
 				m.gameRepository.EXPECT().Get("1001-1001-1001-1001").Return(game, nil)
 			},
 		},
+		{
+			name: "Should get game successfully - with the time played so far",
+			args: args{id: "1001-1001-1001-1001"},
+			want: want{result: withElapsed(startedAt(gameWithBombsHidden, testNow.Add(-time.Minute)), time.Minute)},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001-1001-1001-1001").Return(startedAt(game, testNow.Add(-time.Minute)), nil)
+			},
+		},
+		{
+			name: "Should get the whole board of a finished game",
+			args: args{id: "1001-1001-1001-1001"},
+			want: want{result: finishedGame},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001-1001-1001-1001").Return(finishedGame, nil)
+			},
+		},
 		{
 			name: "Should return error - game not found",
 			args: args{id: "1001-1001-1001-1001"},
@@ -86,10 +124,13 @@ func TestGet(t *testing.T) {
 		m := mocks{
 			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
 			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
 		}
 
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
 		tt.mocks(m)
-		service := usecases.New(m.gameRepository, m.uidGen)
+		service := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
 
 		// Execute
 		result, err := service.Get(tt.args.id)
@@ -115,13 +156,20 @@ func TestCreate(t *testing.T) {
 		true,
 		[]pos{{0, 0}, {1, 1}},
 		[]pos{})
+	gameWithBombsHidden.BoardSettings.Difficulty = domain.DifficultyCustom
+
+	gameWithListedMines := withListedMines(easymockGame("1001", "mygame", 4, "", true, []pos{{0, 0}, {3, 1}}, []pos{}), []pos{{0, 0}, {3, 1}})
+	gameWithListedMines.BoardSettings.Difficulty = domain.DifficultyCustom
+
+	drawn := testSeed
 
 	// · Tests · //
 
+	seed := int64(42)
+
 	type args struct {
-		name  string
-		size  uint
-		bombs uint
+		name     string
+		settings domain.BoardSettings
 	}
 
 	type want struct {
@@ -137,16 +185,117 @@ func TestCreate(t *testing.T) {
 	}{
 		{
 			name: "Should create a new game successfully",
-			args: args{name: "mygame", size: 4, bombs: 2},
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2}},
 			want: want{result: gameWithBombsHidden},
 			mocks: func(m mocks) {
 				m.uidGen.EXPECT().New().Return("1001")
 				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
 			},
 		},
+		{
+			name: "Should create a new rectangular game successfully",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 16, Cols: 30, Bombs: 99}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 16, Cols: 30, Bombs: 99, Seed: &drawn, Difficulty: domain.DifficultyCustom})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should create a new hex game successfully",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Topology: domain.TopologyHex}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Seed: &drawn, Topology: domain.TopologyHex, Difficulty: domain.DifficultyCustom})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should create a new game successfully - with several lives",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 3, Lives: 2}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 3, Seed: &drawn, Lives: 2, Difficulty: domain.DifficultyCustom})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should create a new game successfully - a single life with a single bomb",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 1, Lives: 1}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 1, Seed: &drawn, Lives: 1, Difficulty: domain.DifficultyCustom})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should create a new game successfully - a life for every bomb",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Lives: 2}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Seed: &drawn, Lives: 2, Difficulty: domain.DifficultyCustom})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "Should return an error - more lives than bombs",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Lives: 3}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the number of lives cannot be higher than the number of bombs")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name: "Should return an error - draw seed has fail",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2}},
+			want: want{err: errors.New(apperrors.Internal, nil, "draw a seed for the game has failed")},
+			mocks: func(m mocks) {
+				m.random.EXPECT().Seed().Return(int64(0), errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+		{
+			name:  "Should return an error - unknown topology",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Topology: "triangle"}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "topology must be square, hex or torus")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name: "Should create a new game successfully - with the given seed",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Seed: &seed}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2, Seed: &seed, Difficulty: domain.DifficultyCustom})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should create a new game successfully - beginner preset",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Difficulty: domain.DifficultyBeginner}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 9, Cols: 9, Bombs: 10, Seed: &drawn, Difficulty: domain.DifficultyBeginner})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should create a new game successfully - intermediate preset",
+			args: args{name: "mygame", settings: domain.BoardSettings{Difficulty: domain.DifficultyIntermediate}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 16, Cols: 16, Bombs: 40, Seed: &drawn, Difficulty: domain.DifficultyIntermediate})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should create a new game successfully - expert preset",
+			args: args{name: "mygame", settings: domain.BoardSettings{Difficulty: domain.DifficultyExpert}},
+			want: want{result: domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 16, Cols: 30, Bombs: 99, Seed: &drawn, Difficulty: domain.DifficultyExpert})},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
 		{
 			name: "Should return an error - create game into repository fails",
-			args: args{name: "mygame", size: 4, bombs: 2},
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 2}},
 			want: want{err: errors.New(apperrors.Internal, nil, "create game into repository has failed")},
 			mocks: func(m mocks) {
 				m.uidGen.EXPECT().New().Return("1001")
@@ -155,7 +304,76 @@ func TestCreate(t *testing.T) {
 		},
 		{
 			name:  "Should return an error - invalid bombs number",
-			args:  args{name: "mygame", size: 4, bombs: 40},
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 40}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the number of bombs is too high")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name: "Should create a new game successfully - with listed mines",
+			args: args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 5, Mines: []domain.Position{{Row: 0, Col: 0}, {Row: 3, Col: 1}}}},
+			want: want{result: gameWithListedMines},
+			mocks: func(m mocks) {
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:  "Should return an error - mine out of the board",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Mines: []domain.Position{{Row: 0, Col: 0}, {Row: 4, Col: 1}}}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "mine is out of the board")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - mine listed twice",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, Mines: []domain.Position{{Row: 2, Col: 1}, {Row: 2, Col: 1}}}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "mine is listed more than once")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - mines listed for a difficulty preset",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Difficulty: domain.DifficultyExpert, Mines: []domain.Position{{Row: 2, Col: 1}}}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "mines can only be listed for a custom board")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - mines listed without the board dimensions",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Cols: 4, Mines: []domain.Position{{Row: 2, Col: 1}}}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the number of rows must be greater than zero")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - mines listed for a board without guessing",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4, NoGuess: true, Mines: []domain.Position{{Row: 2, Col: 1}}}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "mines cannot be listed for a board without guessing")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - unknown difficulty",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Difficulty: "impossible"}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "difficulty must be beginner, intermediate, expert or custom")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - zero rows",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Cols: 4, Bombs: 2, Difficulty: domain.DifficultyCustom}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the number of rows must be greater than zero")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - zero columns",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Bombs: 2}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the number of columns must be greater than zero")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - zero bombs",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 4, Cols: 4}},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the number of bombs must be greater than zero")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name:  "Should return an error - invalid bombs number on rectangular board",
+			args:  args{name: "mygame", settings: domain.BoardSettings{Rows: 2, Cols: 3, Bombs: 6}},
 			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the number of bombs is too high")},
 			mocks: func(m mocks) {},
 		},
@@ -170,13 +388,17 @@ func TestCreate(t *testing.T) {
 		m := mocks{
 			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
 			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
 		}
 
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
 		tt.mocks(m)
-		gameUseCase := usecases.New(m.gameRepository, m.uidGen)
+		m.random.EXPECT().Seed().Return(testSeed, nil).AnyTimes()
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
 
 		// Execute
-		gameResult, err := gameUseCase.Create(tt.args.name, tt.args.size, tt.args.bombs)
+		gameResult, err := gameUseCase.Create(tt.args.name, tt.args.settings)
 
 		// Verify
 		if tt.want.err != nil && err != nil {
@@ -188,8 +410,201 @@ func TestCreate(t *testing.T) {
 		assert.Equal(t, tt.want.result.Name, gameResult.Name)
 		assert.Equal(t, tt.want.result.State, gameResult.State)
 		assert.Equal(t, tt.want.result.BoardSettings, gameResult.BoardSettings)
-		assert.Equal(t, len(tt.want.result.Board), len(gameResult.Board))
+		assert.Equal(t, tt.want.result.Board.Rows(), gameResult.Board.Rows())
+		if gameResult.Board.Rows() > 0 {
+			assert.Equal(t, tt.want.result.Board.Cols(), gameResult.Board.Cols())
+		}
+	}
+}
+
+func TestCreateWithoutGuessing(t *testing.T) {
+	seed := int64(1)
+
+	// Prepare
+	m := mocks{
+		gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+		uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+		clock:          mockups.NewMockClock(gomock.NewController(t)),
+		random:         mockups.NewMockRandom(gomock.NewController(t)),
+	}
+
+	var savedGame domain.Game
+	m.uidGen.EXPECT().New().Return("1001")
+	m.gameRepository.EXPECT().Save(gomock.Any()).Do(func(game domain.Game) { savedGame = game }).Return(nil)
+	m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+
+	gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+	// Execute
+	gameResult, err := gameUseCase.Create("mygame", domain.BoardSettings{Rows: 9, Cols: 9, Bombs: 10, Seed: &seed, NoGuess: true})
+
+	// Verify
+	assert.Nil(t, err)
+	assert.True(t, savedGame.Seeded)
+	assert.True(t, savedGame.Board.IsRevealed(4, 4))
+	assert.Equal(t, &domain.Position{Row: 4, Col: 4}, savedGame.BoardSettings.Start)
+	assert.Equal(t, uint(10), countMines(savedGame.Board))
+	assert.True(t, solver.IsSolvable(savedGame.Board, 4, 4, solver.Deadline{}))
+	assert.Equal(t, savedGame.Board.ThreeBVLeft(), savedGame.ThreeBV)
+	assert.Less(t, savedGame.ThreeBV, savedGame.Board.ThreeBV())
+	assert.True(t, gameResult.Board.IsRevealed(4, 4))
+	assert.Equal(t, savedGame.Board.HideBombs(), gameResult.Board)
+}
+
+func TestCreateWithMines(t *testing.T) {
+	// Prepare
+	m := mocks{
+		gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+		uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+		clock:          mockups.NewMockClock(gomock.NewController(t)),
+		random:         mockups.NewMockRandom(gomock.NewController(t)),
+	}
+
+	var savedGame domain.Game
+	m.uidGen.EXPECT().New().Return("1001")
+	m.random.EXPECT().Seed().Return(testSeed, nil)
+	m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+	m.gameRepository.EXPECT().Save(gomock.Any()).Do(func(game domain.Game) { savedGame = game }).Return(nil).Times(2)
+
+	gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+	// Execute
+	_, err := gameUseCase.Create("mygame", domain.BoardSettings{Rows: 3, Cols: 3, Mines: []domain.Position{{Row: 1, Col: 1}, {Row: 2, Col: 0}}})
+
+	// Verify
+	assert.Nil(t, err)
+	assert.True(t, savedGame.Seeded)
+	assert.Equal(t, uint(2), savedGame.BoardSettings.Bombs)
+	assert.Equal(t, uint(2), countMines(savedGame.Board))
+	assert.True(t, savedGame.Board.IsMine(1, 1))
+	assert.True(t, savedGame.Board.IsMine(2, 0))
+	assert.Equal(t, savedGame.Board.ThreeBV(), savedGame.ThreeBV)
+
+	// Execute - the first click is not moved off a listed mine
+	m.gameRepository.EXPECT().Get("1001").Return(savedGame, nil)
+	gameResult, err := gameUseCase.Reveal("1001", 1, 1)
+
+	// Verify
+	assert.Nil(t, err)
+	assert.Equal(t, domain.GameStateLost, gameResult.State)
+	assert.True(t, gameResult.Board.IsExploded(1, 1))
+}
+
+func TestCreateWithoutGuessingTooDense(t *testing.T) {
+	// Prepare
+	m := mocks{
+		gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+		uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+		clock:          mockups.NewMockClock(gomock.NewController(t)),
+		random:         mockups.NewMockRandom(gomock.NewController(t)),
+	}
+
+	m.uidGen.EXPECT().New().Return("1001")
+	m.random.EXPECT().Seed().Return(testSeed, nil)
+	m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+
+	gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+	// Execute
+	_, err := gameUseCase.Create("mygame", domain.BoardSettings{Rows: 5, Cols: 5, Bombs: 20, NoGuess: true})
+
+	// Verify
+	assert.Equal(t, errors.Code(apperrors.InvalidInput), errors.Code(err))
+	assert.Equal(t, "a board without guessing cannot be generated with this number of bombs", err.Error())
+}
+
+func TestCreateWithoutGuessingLeavesACellToReveal(t *testing.T) {
+	seed := int64(0)
+
+	// Prepare
+	m := mocks{
+		gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+		uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+		clock:          mockups.NewMockClock(gomock.NewController(t)),
+		random:         mockups.NewMockRandom(gomock.NewController(t)),
+	}
+
+	var savedGame domain.Game
+	m.uidGen.EXPECT().New().Return("1001")
+	m.gameRepository.EXPECT().Save(gomock.Any()).Do(func(game domain.Game) { savedGame = game }).Return(nil)
+	m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+
+	gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+	// Execute
+	gameResult, err := gameUseCase.Create("mygame", domain.BoardSettings{Rows: 5, Cols: 5, Bombs: 1, Seed: &seed, NoGuess: true})
+
+	// Verify: the opening leaves a safe cell for the player to reveal
+	assert.Nil(t, err)
+	assert.Equal(t, domain.GameStateNew, gameResult.State)
+	assert.True(t, savedGame.Board.IsCellEmpty())
+	assert.True(t, solver.IsSolvable(savedGame.Board, 2, 2, solver.Deadline{}))
+}
+
+func TestCreateWithoutGuessingTooSparse(t *testing.T) {
+	// Prepare
+	m := mocks{
+		gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+		uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+		clock:          mockups.NewMockClock(gomock.NewController(t)),
+		random:         mockups.NewMockRandom(gomock.NewController(t)),
+	}
+
+	m.uidGen.EXPECT().New().Return("1001")
+	m.random.EXPECT().Seed().Return(testSeed, nil)
+	m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+
+	gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+	// Execute: the bomb can only lie at either end, and the opening then clears every other cell
+	_, err := gameUseCase.Create("mygame", domain.BoardSettings{Rows: 1, Cols: 5, Bombs: 1, NoGuess: true})
+
+	// Verify
+	assert.Equal(t, errors.Code(apperrors.InvalidInput), errors.Code(err))
+	assert.Equal(t, "a board without guessing cannot be generated with this number of bombs", err.Error())
+}
+
+func TestCreateWithoutGuessingTimesOut(t *testing.T) {
+	// Prepare
+	m := mocks{
+		gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+		uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+		clock:          mockups.NewMockClock(gomock.NewController(t)),
+		random:         mockups.NewMockRandom(gomock.NewController(t)),
+	}
+
+	m.uidGen.EXPECT().New().Return("1001")
+	m.random.EXPECT().Seed().Return(testSeed, nil)
+	m.clock.EXPECT().Now().Return(testNow).Times(1)
+	m.clock.EXPECT().Now().Return(testNow.Add(3 * time.Second)).AnyTimes()
+
+	gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+	// Execute
+	_, err := gameUseCase.Create("mygame", domain.BoardSettings{Rows: 50, Cols: 50, Bombs: 300, NoGuess: true})
+
+	// Verify
+	assert.Equal(t, errors.Code(apperrors.InvalidInput), errors.Code(err))
+	assert.Equal(t, "a board without guessing cannot be generated with this number of bombs", err.Error())
+}
+
+func TestCreateWithoutGuessingTooLarge(t *testing.T) {
+	// Prepare
+	m := mocks{
+		gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+		uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+		clock:          mockups.NewMockClock(gomock.NewController(t)),
+		random:         mockups.NewMockRandom(gomock.NewController(t)),
 	}
+
+	gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+	// Execute
+	_, err := gameUseCase.Create("mygame", domain.BoardSettings{Rows: 100, Cols: 100, Bombs: 1500, NoGuess: true})
+
+	// Verify
+	assert.Equal(t, errors.Code(apperrors.InvalidInput), errors.Code(err))
+	assert.Equal(t, "a board without guessing can have at most 2500 cells", err.Error())
 }
 
 func TestReveal(t *testing.T) {
@@ -217,10 +632,10 @@ func TestReveal(t *testing.T) {
 		{
 			name: "Should reveal cell successfully - result in game not over",
 			args: args{id: "1001", row: 2, col: 2},
-			want: want{result: easymockGame("1001", "mygame", 4, "", true, []pos{{1, 1}}, []pos{{2, 2}})},
+			want: want{result: played(minedGame(t, 4, "", true, []pos{{1, 1}}, []pos{{2, 2}}), domain.Move{Action: domain.MoveReveal, Row: 2, Col: 2, Outcome: domain.MoveOutcomeOpened})},
 			mocks: func(m mocks) {
-				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
-				gameToSave := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{{2, 2}})
+				game := minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{})
+				gameToSave := played(minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{{2, 2}}), domain.Move{Action: domain.MoveReveal, Row: 2, Col: 2, Outcome: domain.MoveOutcomeOpened})
 
 				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
 				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
@@ -229,22 +644,90 @@ func TestReveal(t *testing.T) {
 		{
 			name: "Should reveal cell successfully - result in game over - lost",
 			args: args{id: "1001", row: 1, col: 1},
-			want: want{result: easymockGame("1001", "mygame", 4, domain.GameStateLost, true, []pos{{1, 1}}, []pos{})},
+			want: want{result: played(withExploded(minedGame(t, 4, domain.GameStateLost, false, []pos{{1, 1}}, []pos{}), pos{1, 1}), domain.Move{Action: domain.MoveReveal, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded})},
 			mocks: func(m mocks) {
-				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
-				gameToSave := easymockGame("1001", "mygame", 4, domain.GameStateLost, false, []pos{{1, 1}}, []pos{})
+				game := minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{})
+				gameToSave := played(withExploded(minedGame(t, 4, domain.GameStateLost, false, []pos{{1, 1}}, []pos{}), pos{1, 1}), domain.Move{Action: domain.MoveReveal, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should reveal a bomb successfully - lives left",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: visible(played(withExploded(withLives(minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{}), 2), pos{1, 1}), domain.Move{Action: domain.MoveReveal, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded}))},
+			mocks: func(m mocks) {
+				game := withLives(minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{}), 2)
+				gameToSave := played(withExploded(withLives(minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{}), 2), pos{1, 1}), domain.Move{Action: domain.MoveReveal, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should reveal a bomb successfully - result in game over - no lives left",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: played(withExploded(withExploded(withLives(minedGame(t, 4, domain.GameStateLost, false, []pos{{0, 0}, {1, 1}}, []pos{}), 2), pos{0, 0}), pos{1, 1}), domain.Move{Action: domain.MoveReveal, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded})},
+			mocks: func(m mocks) {
+				game := withExploded(withLives(minedGame(t, 4, "", false, []pos{{0, 0}, {1, 1}}, []pos{}), 2), pos{0, 0})
+				gameToSave := played(withExploded(withExploded(withLives(minedGame(t, 4, domain.GameStateLost, false, []pos{{0, 0}, {1, 1}}, []pos{}), 2), pos{0, 0}), pos{1, 1}), domain.Move{Action: domain.MoveReveal, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded})
 
 				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
 				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
 			},
 		},
+		{
+			name: "Should return an error - cell is already revealed",
+			args: args{id: "1001", row: 0, col: 1},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "cell is already revealed")},
+			mocks: func(m mocks) {
+				game := minedGame(t, 2, domain.GameStateInProgress, false, []pos{{1, 1}}, []pos{{0, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - bomb already set off",
+			args: args{id: "1001", row: 0, col: 0},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "cell is already revealed")},
+			mocks: func(m mocks) {
+				game := withExploded(withLives(minedGame(t, 4, "", false, []pos{{0, 0}, {1, 1}}, []pos{}), 2), pos{0, 0})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
 		{
 			name: "Should reveal cell successfully - result in game over - won",
 			args: args{id: "1001", row: 0, col: 0},
-			want: want{result: easymockGame("1001", "mygame", 2, domain.GameStateWon, true, []pos{{1, 1}}, []pos{{0, 0}, {0, 1}, {1, 0}})},
+			want: want{result: played(minedGame(t, 2, domain.GameStateWon, false, []pos{{1, 1}}, []pos{{0, 0}, {0, 1}, {1, 0}}), domain.Move{Action: domain.MoveReveal, Row: 0, Col: 0, Outcome: domain.MoveOutcomeOpened})},
+			mocks: func(m mocks) {
+				game := minedGame(t, 2, "", false, []pos{{1, 1}}, []pos{{0, 1}, {1, 0}})
+				gameToSave := played(minedGame(t, 2, domain.GameStateWon, false, []pos{{1, 1}}, []pos{{0, 0}, {0, 1}, {1, 0}}), domain.Move{Action: domain.MoveReveal, Row: 0, Col: 0, Outcome: domain.MoveOutcomeOpened})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should reveal cell successfully - result in game over - won - with the time played",
+			args: args{id: "1001", row: 0, col: 0},
+			want: want{result: withElapsed(played(startedAt(minedGame(t, 2, domain.GameStateWon, false, []pos{{1, 1}}, []pos{{0, 0}, {0, 1}, {1, 0}}), testNow.Add(-90*time.Second)), domain.Move{Action: domain.MoveReveal, Row: 0, Col: 0, Outcome: domain.MoveOutcomeOpened}), 90*time.Second)},
+			mocks: func(m mocks) {
+				game := startedAt(minedGame(t, 2, "", false, []pos{{1, 1}}, []pos{{0, 1}, {1, 0}}), testNow.Add(-90*time.Second))
+				gameToSave := played(startedAt(minedGame(t, 2, domain.GameStateWon, false, []pos{{1, 1}}, []pos{{0, 0}, {0, 1}, {1, 0}}), testNow.Add(-90*time.Second)), domain.Move{Action: domain.MoveReveal, Row: 0, Col: 0, Outcome: domain.MoveOutcomeOpened})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should reveal cell successfully - empty area is opened",
+			args: args{id: "1001", row: 0, col: 2},
+			want: want{result: played(minedGame(t, 3, domain.GameStateWon, false, []pos{{2, 0}}, []pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}, {2, 1}, {2, 2}}), domain.Move{Action: domain.MoveReveal, Row: 0, Col: 2, Outcome: domain.MoveOutcomeOpened})},
 			mocks: func(m mocks) {
-				game := easymockGame("1001", "mygame", 2, "", false, []pos{{1, 1}}, []pos{{0, 1}, {1, 0}})
-				gameToSave := easymockGame("1001", "mygame", 2, domain.GameStateWon, false, []pos{{1, 1}}, []pos{{0, 0}, {0, 1}, {1, 0}})
+				game := minedGame(t, 3, "", false, []pos{{2, 0}}, []pos{})
+				gameToSave := played(minedGame(t, 3, domain.GameStateWon, false, []pos{{2, 0}}, []pos{{0, 0}, {0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}, {2, 1}, {2, 2}}), domain.Move{Action: domain.MoveReveal, Row: 0, Col: 2, Outcome: domain.MoveOutcomeOpened})
 
 				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
 				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
@@ -271,7 +754,7 @@ func TestReveal(t *testing.T) {
 			args: args{id: "1001", row: 20, col: 2},
 			want: want{err: errors.New(apperrors.InvalidInput, nil, "invalid position")},
 			mocks: func(m mocks) {
-				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
+				game := minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{})
 
 				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
 			},
@@ -281,7 +764,27 @@ func TestReveal(t *testing.T) {
 			args: args{id: "1001", row: 2, col: 2},
 			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
 			mocks: func(m mocks) {
-				game := easymockGame("1001", "mygame", 4, domain.GameStateLost, false, []pos{{1, 1}}, []pos{})
+				game := minedGame(t, 4, domain.GameStateLost, false, []pos{{1, 1}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game is paused",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is paused")},
+			mocks: func(m mocks) {
+				game := minedGame(t, 4, domain.GameStatePaused, false, []pos{{1, 1}}, []pos{{0, 0}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - cell is flagged",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "cell is flagged")},
+			mocks: func(m mocks) {
+				game := withFlags(minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{}), []pos{{1, 1}})
 
 				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
 			},
@@ -291,8 +794,8 @@ func TestReveal(t *testing.T) {
 			args: args{id: "1001", row: 2, col: 2},
 			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
 			mocks: func(m mocks) {
-				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
-				gameToSave := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{{2, 2}})
+				game := minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{})
+				gameToSave := played(minedGame(t, 4, "", false, []pos{{1, 1}}, []pos{{2, 2}}), domain.Move{Action: domain.MoveReveal, Row: 2, Col: 2, Outcome: domain.MoveOutcomeOpened})
 
 				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
 				m.gameRepository.EXPECT().Save(gameToSave).Return(errors.New(apperrors.Internal, nil, ""))
@@ -309,10 +812,13 @@ func TestReveal(t *testing.T) {
 		m := mocks{
 			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
 			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
 		}
 
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
 		tt.mocks(m)
-		gameUseCase := usecases.New(m.gameRepository, m.uidGen)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
 
 		// Execute
 		gameResult, err := gameUseCase.Reveal(tt.args.id, tt.args.row, tt.args.col)
@@ -327,21 +833,1690 @@ func TestReveal(t *testing.T) {
 	}
 }
 
-type pos struct {
-	row uint
-	col uint
-}
+func TestRevealFirstClick(t *testing.T) {
+	for i := 0; i < 50; i++ {
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
 
-func easymockGame(id string, name string, size uint, state string, hideBombs bool, bombs []pos, revealed []pos) domain.Game {
-	game := domain.NewGame(id, name, size, 0)
-	game.BoardSettings.Bombs = uint(len(bombs))
+		var savedGame domain.Game
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		m.random.EXPECT().Seed().Return(int64(i), nil)
+		m.gameRepository.EXPECT().Get("1001").Return(domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 4, Cols: 4, Bombs: 15}), nil)
+		m.gameRepository.EXPECT().Save(gomock.Any()).Do(func(game domain.Game) { savedGame = game }).Return(nil)
 
-	for _, pos := range bombs {
-		game.Board[pos.row][pos.col] = domain.CellBomb
-	}
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
 
-	for _, pos := range revealed {
-		game.Board[pos.row][pos.col] = domain.CellRevealed
+		// Execute
+		gameResult, err := gameUseCase.Reveal("1001", 2, 1)
+
+		// Verify
+		assert.Nil(t, err)
+		assert.True(t, savedGame.Seeded)
+		assert.Equal(t, domain.GameStateWon, gameResult.State)
+		assert.False(t, savedGame.Board.IsMine(2, 1))
+		assert.True(t, savedGame.Board.IsRevealed(2, 1))
+	}
+}
+
+func TestFlag(t *testing.T) {
+	// · Mocks · //
+
+	// · Tests · //
+
+	type args struct {
+		id  string
+		row uint
+		col uint
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should flag cell successfully",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: played(withFlags(easymockGame("1001", "mygame", 4, "", true, []pos{{1, 1}}, []pos{}), []pos{{1, 1}}), domain.Move{Action: domain.MoveFlag, Row: 1, Col: 1, Outcome: domain.MoveOutcomeFlagged})},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
+				gameToSave := played(withFlags(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), []pos{{1, 1}}), domain.Move{Action: domain.MoveFlag, Row: 1, Col: 1, Outcome: domain.MoveOutcomeFlagged})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should unflag cell successfully",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{result: played(easymockGame("1001", "mygame", 4, "", true, []pos{{1, 1}}, []pos{}), domain.Move{Action: domain.MoveFlag, Row: 2, Col: 2, Outcome: domain.MoveOutcomeUnflagged})},
+			mocks: func(m mocks) {
+				game := withFlags(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), []pos{{2, 2}})
+				gameToSave := played(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), domain.Move{Action: domain.MoveFlag, Row: 2, Col: 2, Outcome: domain.MoveOutcomeUnflagged})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - invalid position",
+			args: args{id: "1001", row: 2, col: 20},
+			want: want{err: errors.New(apperrors.InvalidInput, nil, "invalid position")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, domain.GameStateWon, false, []pos{{1, 1}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - cell is already revealed",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "cell is already revealed")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{{2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		gameResult, err := gameUseCase.Flag(tt.args.id, tt.args.row, tt.args.col)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestMark(t *testing.T) {
+	// · Tests · //
+
+	type args struct {
+		id  string
+		row uint
+		col uint
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should mark cell successfully - flag",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: played(withFlags(easymockGame("1001", "mygame", 4, "", true, []pos{{1, 1}}, []pos{}), []pos{{1, 1}}), domain.Move{Action: domain.MoveMark, Row: 1, Col: 1, Outcome: domain.MoveOutcomeFlagged})},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
+				gameToSave := played(withFlags(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), []pos{{1, 1}}), domain.Move{Action: domain.MoveMark, Row: 1, Col: 1, Outcome: domain.MoveOutcomeFlagged})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should mark cell successfully - question mark",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: played(withQuestions(easymockGame("1001", "mygame", 4, "", true, []pos{{1, 1}}, []pos{}), []pos{{1, 1}}), domain.Move{Action: domain.MoveMark, Row: 1, Col: 1, Outcome: domain.MoveOutcomeQuestioned})},
+			mocks: func(m mocks) {
+				game := withFlags(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), []pos{{1, 1}})
+				gameToSave := played(withQuestions(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), []pos{{1, 1}}), domain.Move{Action: domain.MoveMark, Row: 1, Col: 1, Outcome: domain.MoveOutcomeQuestioned})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should mark cell successfully - no mark",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: played(easymockGame("1001", "mygame", 4, "", true, []pos{{1, 1}}, []pos{}), domain.Move{Action: domain.MoveMark, Row: 1, Col: 1, Outcome: domain.MoveOutcomeUnmarked})},
+			mocks: func(m mocks) {
+				game := withQuestions(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), []pos{{1, 1}})
+				gameToSave := played(easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{}), domain.Move{Action: domain.MoveMark, Row: 1, Col: 1, Outcome: domain.MoveOutcomeUnmarked})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - invalid position",
+			args: args{id: "1001", row: 2, col: 20},
+			want: want{err: errors.New(apperrors.InvalidInput, nil, "invalid position")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, domain.GameStateLost, false, []pos{{1, 1}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - cell is already revealed",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "cell is already revealed")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{{2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 4, "", false, []pos{{1, 1}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		gameResult, err := gameUseCase.Mark(tt.args.id, tt.args.row, tt.args.col)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestChord(t *testing.T) {
+	// · Mocks · //
+
+	allButCorners := []pos{
+		{0, 1}, {0, 2}, {0, 3},
+		{1, 0}, {1, 1}, {1, 2}, {1, 3},
+		{2, 0}, {2, 1}, {2, 2}, {2, 3},
+		{3, 0}, {3, 1}, {3, 2},
+	}
+
+	// · Tests · //
+
+	type args struct {
+		id  string
+		row uint
+		col uint
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should chord cell successfully - result in game over - won",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: played(withFlags(minedGame(t, 4, domain.GameStateWon, false, []pos{{0, 0}, {3, 3}}, allButCorners), []pos{{0, 0}}), domain.Move{Action: domain.MoveChord, Row: 1, Col: 1, Outcome: domain.MoveOutcomeOpened})},
+			mocks: func(m mocks) {
+				game := withFlags(minedGame(t, 4, "", false, []pos{{0, 0}, {3, 3}}, []pos{{1, 1}}), []pos{{0, 0}})
+				gameToSave := played(withFlags(minedGame(t, 4, domain.GameStateWon, false, []pos{{0, 0}, {3, 3}}, allButCorners), []pos{{0, 0}}), domain.Move{Action: domain.MoveChord, Row: 1, Col: 1, Outcome: domain.MoveOutcomeOpened})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should chord cell successfully - result in game over - lost",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{result: played(withExploded(withFlags(minedGame(t, 3, domain.GameStateLost, false, []pos{{0, 0}}, []pos{{1, 1}}), []pos{{0, 1}}), pos{0, 0}), domain.Move{Action: domain.MoveChord, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded})},
+			mocks: func(m mocks) {
+				game := withFlags(minedGame(t, 3, "", false, []pos{{0, 0}}, []pos{{1, 1}}), []pos{{0, 1}})
+				gameToSave := played(withExploded(withFlags(minedGame(t, 3, domain.GameStateLost, false, []pos{{0, 0}}, []pos{{1, 1}}), []pos{{0, 1}}), pos{0, 0}), domain.Move{Action: domain.MoveChord, Row: 1, Col: 1, Outcome: domain.MoveOutcomeExploded})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - invalid position",
+			args: args{id: "1001", row: 10, col: 1},
+			want: want{err: errors.New(apperrors.InvalidInput, nil, "invalid position")},
+			mocks: func(m mocks) {
+				game := minedGame(t, 4, "", false, []pos{{0, 0}}, []pos{{1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := minedGame(t, 4, domain.GameStateLost, false, []pos{{0, 0}}, []pos{{1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - cell is not revealed",
+			args: args{id: "1001", row: 2, col: 2},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "cell is not revealed")},
+			mocks: func(m mocks) {
+				game := minedGame(t, 4, "", false, []pos{{0, 0}}, []pos{{1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - flags do not match the cell number",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "adjacent flags do not match the cell number")},
+			mocks: func(m mocks) {
+				game := minedGame(t, 4, "", false, []pos{{0, 0}}, []pos{{1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001", row: 1, col: 1},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := withFlags(minedGame(t, 4, "", false, []pos{{0, 0}}, []pos{{1, 1}}), []pos{{0, 0}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		gameResult, err := gameUseCase.Chord(tt.args.id, tt.args.row, tt.args.col)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestHint(t *testing.T) {
+	// · Tests · //
+
+	type args struct {
+		id string
+	}
+
+	type want struct {
+		result domain.Position
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should give a safe cell successfully",
+			args: args{id: "1001"},
+			want: want{result: domain.Position{Row: 0, Col: 1}},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}, {2, 2}})
+				gameToSave := assisted(easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}, {2, 2}}))
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should give a safe cell successfully - skipping misplaced flags",
+			args: args{id: "1001"},
+			want: want{result: domain.Position{Row: 0, Col: 2}},
+			mocks: func(m mocks) {
+				game := withFlags(easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}, {2, 2}}), []pos{{0, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - every safe cell is flagged",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "every cell proven safe is flagged")},
+			mocks: func(m mocks) {
+				game := withFlags(easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}, {2, 2}}), []pos{{0, 1}, {0, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should give the center cell successfully - bombs are not placed yet",
+			args: args{id: "1001"},
+			want: want{result: domain.Position{Row: 1, Col: 2}},
+			mocks: func(m mocks) {
+				game := domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 3, Cols: 5, Bombs: 2})
+				gameToSave := assisted(game)
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should give the cell after the center successfully - bombs are not placed yet and the center is flagged",
+			args: args{id: "1001"},
+			want: want{result: domain.Position{Row: 2, Col: 3}},
+			mocks: func(m mocks) {
+				game := withFlags(domain.NewGame("1001", "mygame", domain.BoardSettings{Rows: 5, Cols: 5, Bombs: 2}), []pos{{2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(assisted(game)).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - no cell can be proven safe",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "no cell can be proven safe")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{{1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, domain.GameStateLost, false, []pos{{0, 0}}, []pos{{1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{{1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}, {2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		hint, err := gameUseCase.Hint(tt.args.id)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, hint)
+	}
+}
+
+func TestHeatmap(t *testing.T) {
+	// · Tests · //
+
+	type args struct {
+		id string
+	}
+
+	type want struct {
+		result domain.Heatmap
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should compute the heatmap successfully",
+			args: args{id: "1001"},
+			want: want{result: domain.Heatmap{Exact: true, Cells: []domain.Probability{
+				{Row: 0, Col: 0, Mine: 1.0 / 3},
+				{Row: 0, Col: 1, Mine: 1.0 / 3},
+				{Row: 1, Col: 0, Mine: 1.0 / 3},
+			}}},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 2, "", false, []pos{{0, 0}}, []pos{{1, 1}})
+				gameToSave := assisted(easymockGame("1001", "mygame", 2, "", false, []pos{{0, 0}}, []pos{{1, 1}}))
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 2, domain.GameStateWon, false, []pos{{0, 0}}, []pos{{0, 1}, {1, 0}, {1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 2, "", false, []pos{{0, 0}}, []pos{{1, 1}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		heatmap, err := gameUseCase.Heatmap(tt.args.id)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, heatmap)
+	}
+}
+
+func TestUndo(t *testing.T) {
+	// · Mocks · //
+
+	mines := []pos{{0, 0}, {2, 2}}
+	opened := []pos{{0, 1}, {0, 2}, {1, 1}, {1, 2}}
+	firstMove := domain.Move{Action: domain.MoveReveal, Row: 0, Col: 2, At: testNow, Outcome: domain.MoveOutcomeOpened, State: domain.GameStateInProgress}
+	losingMove := domain.Move{Action: domain.MoveReveal, Row: 0, Col: 0, At: testNow, Outcome: domain.MoveOutcomeExploded, State: domain.GameStateLost}
+
+	lostGame := practice(withExploded(easymockGame("1001", "mygame", 3, domain.GameStateLost, false, mines, opened), pos{0, 0}))
+	lostGame.Moves = []domain.Move{firstMove, losingMove}
+	lostGame.TrackTime(testNow)
+
+	// · Tests · //
+
+	type args struct {
+		id    string
+		moves uint
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should undo the losing click successfully",
+			args: args{id: "1001", moves: 1},
+			want: want{result: played(practice(easymockGame("1001", "mygame", 3, "", true, mines, opened)), firstMove)},
+			mocks: func(m mocks) {
+				gameToSave := played(practice(easymockGame("1001", "mygame", 3, "", false, mines, opened)), firstMove)
+
+				m.gameRepository.EXPECT().Get("1001").Return(lostGame, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should undo every move successfully - bombs are placed again on the next reveal",
+			args: args{id: "1001", moves: 2},
+			want: want{result: startedAt(unseeded(practice(easymockGame("1001", "mygame", 3, "", true, nil, nil)), mines), testNow)},
+			mocks: func(m mocks) {
+				gameToSave := startedAt(unseeded(practice(easymockGame("1001", "mygame", 3, "", false, nil, nil)), mines), testNow)
+
+				m.gameRepository.EXPECT().Get("1001").Return(lostGame, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should undo every move successfully - listed mines stay in place",
+			args: args{id: "1001", moves: 2},
+			want: want{result: startedAt(withListedMines(practice(easymockGame("1001", "mygame", 3, "", true, mines, nil)), mines), testNow)},
+			mocks: func(m mocks) {
+				game := withListedMines(lostGame, mines)
+				gameToSave := startedAt(withListedMines(practice(easymockGame("1001", "mygame", 3, "", false, mines, nil)), mines), testNow)
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001", moves: 1},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - ranked game",
+			args: args{id: "1001", moves: 1},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "moves can only be undone in practice games")},
+			mocks: func(m mocks) {
+				game := lostGame
+				game.BoardSettings.Practice = false
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - abandoned game",
+			args: args{id: "1001", moves: 1},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := lostGame
+				game.State = domain.GameStateAbandoned
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game not started",
+			args: args{id: "1001", moves: 1},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "the game does not allow this operation in its current state")},
+			mocks: func(m mocks) {
+				game := practice(easymockGame("1001", "mygame", 3, "", false, mines, nil))
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - no moves to undo",
+			args: args{id: "1001", moves: 0},
+			want: want{err: errors.New(apperrors.InvalidInput, nil, "the number of moves to undo must be greater than zero")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(lostGame, nil)
+			},
+		},
+		{
+			name: "Should return an error - more moves than played",
+			args: args{id: "1001", moves: 3},
+			want: want{err: errors.New(apperrors.InvalidInput, nil, "the number of moves to undo is higher than the moves played")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(lostGame, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001", moves: 1},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(lostGame, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		gameResult, err := gameUseCase.Undo(tt.args.id, tt.args.moves)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestPause(t *testing.T) {
+	// · Tests · //
+
+	type args struct {
+		id string
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should pause the game successfully",
+			args: args{id: "1001"},
+			want: want{result: withElapsed(pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, true, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow), time.Minute)},
+			mocks: func(m mocks) {
+				game := startedAt(easymockGame("1001", "mygame", 3, domain.GameStateInProgress, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute))
+				gameToSave := pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow)
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should pause the game and mask its board",
+			args: args{id: "1001"},
+			want: want{result: withElapsed(visible(pausedAt(maskPaused(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute))), testNow)), time.Minute)},
+			mocks: func(m mocks) {
+				game := maskPaused(startedAt(easymockGame("1001", "mygame", 3, domain.GameStateInProgress, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)))
+				gameToSave := pausedAt(maskPaused(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute))), testNow)
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, domain.GameStateWon, false, []pos{{0, 0}}, []pos{{2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game is already paused",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is paused")},
+			mocks: func(m mocks) {
+				game := pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-time.Second))
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game has not started",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "the game does not allow this operation in its current state")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := startedAt(easymockGame("1001", "mygame", 3, domain.GameStateInProgress, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute))
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		gameResult, err := gameUseCase.Pause(tt.args.id)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestResume(t *testing.T) {
+	// · Tests · //
+
+	type args struct {
+		id string
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should resume the game successfully",
+			args: args{id: "1001"},
+			want: want{result: withElapsed(resumedAt(pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStateInProgress, true, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-20*time.Second)), testNow), 40*time.Second)},
+			mocks: func(m mocks) {
+				game := pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-20*time.Second))
+				gameToSave := resumedAt(pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStateInProgress, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-20*time.Second)), testNow)
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, domain.GameStateAbandoned, false, []pos{{0, 0}}, []pos{{2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - game is not paused",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "the game does not allow this operation in its current state")},
+			mocks: func(m mocks) {
+				game := startedAt(easymockGame("1001", "mygame", 3, domain.GameStateInProgress, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute))
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-20*time.Second))
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		gameResult, err := gameUseCase.Resume(tt.args.id)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestAbandon(t *testing.T) {
+	// · Tests · //
+
+	type args struct {
+		id string
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should abandon the game successfully",
+			args: args{id: "1001"},
+			want: want{result: withElapsed(finishedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStateAbandoned, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow), time.Minute)},
+			mocks: func(m mocks) {
+				game := startedAt(easymockGame("1001", "mygame", 3, domain.GameStateInProgress, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute))
+				gameToSave := finishedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStateAbandoned, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow)
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should abandon a paused game, leaving the pause out of its time",
+			args: args{id: "1001"},
+			want: want{result: withElapsed(finishedAt(pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStateAbandoned, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-15*time.Second)), testNow), 45*time.Second)},
+			mocks: func(m mocks) {
+				game := pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStatePaused, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-15*time.Second))
+				gameToSave := finishedAt(pausedAt(startedAt(easymockGame("1001", "mygame", 3, domain.GameStateAbandoned, false, []pos{{0, 0}}, []pos{{2, 2}}), testNow.Add(-time.Minute)), testNow.Add(-15*time.Second)), testNow)
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gameToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - game is over",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is over")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, domain.GameStateWon, false, []pos{{0, 0}}, []pos{{2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.Internal, nil, "update game into repository has failed")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		gameResult, err := gameUseCase.Abandon(tt.args.id)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestReplay(t *testing.T) {
+	// · Mocks · //
+
+	finishedGame := withFlags(easymockGame("1001", "mygame", 3, domain.GameStateWon, false, []pos{{0, 0}, {2, 2}}, []pos{{0, 1}, {0, 2}, {1, 0}, {1, 1}, {1, 2}, {2, 0}, {2, 1}}), []pos{{2, 2}})
+	finishedGame.Moves = []domain.Move{
+		{Action: domain.MoveReveal, Row: 0, Col: 2, At: testNow, Outcome: domain.MoveOutcomeOpened, State: domain.GameStateInProgress},
+		{Action: domain.MoveFlag, Row: 2, Col: 2, At: testNow.Add(time.Second), Outcome: domain.MoveOutcomeFlagged, State: domain.GameStateInProgress},
+		{Action: domain.MoveReveal, Row: 2, Col: 0, At: testNow.Add(2 * time.Second), Outcome: domain.MoveOutcomeOpened, State: domain.GameStateWon},
+	}
+
+	// · Tests · //
+
+	type args struct {
+		id string
+	}
+
+	type step struct {
+		move  domain.Move
+		state string
+		board [][]string
+	}
+
+	type want struct {
+		steps []step
+		err   error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should replay the game successfully",
+			args: args{id: "1001"},
+			want: want{steps: []step{
+				{move: finishedGame.Moves[0], state: domain.GameStateInProgress, board: [][]string{{"X", "1", "0"}, {"-", "2", "1"}, {"-", "-", "X"}}},
+				{move: finishedGame.Moves[1], state: domain.GameStateInProgress, board: [][]string{{"X", "1", "0"}, {"-", "2", "1"}, {"-", "-", "F"}}},
+				{move: finishedGame.Moves[2], state: domain.GameStateWon, board: [][]string{{"X", "1", "0"}, {"1", "2", "1"}, {"0", "1", "F"}}},
+			}},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(finishedGame, nil)
+			},
+		},
+		{
+			name: "Should return an error - game not found",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.NotFound, nil, "game not found")},
+			mocks: func(m mocks) {
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - game is not over yet",
+			args: args{id: "1001"},
+			want: want{err: errors.New(apperrors.IllegalOperation, nil, "game is not over yet")},
+			mocks: func(m mocks) {
+				game := easymockGame("1001", "mygame", 3, "", false, []pos{{0, 0}}, []pos{{2, 2}})
+
+				m.gameRepository.EXPECT().Get("1001").Return(game, nil)
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository: mockups.NewMockGamesRepository(gomock.NewController(t)),
+			uidGen:         mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:          mockups.NewMockClock(gomock.NewController(t)),
+			random:         mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		tt.mocks(m)
+		gameUseCase := usecases.New(m.gameRepository, m.uidGen, m.clock, m.random)
+
+		// Execute
+		steps, err := gameUseCase.Replay(tt.args.id)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, len(tt.want.steps), len(steps))
+		for i := range steps {
+			assert.Equal(t, tt.want.steps[i].move, steps[i].Move)
+			assert.Equal(t, tt.want.steps[i].state, steps[i].State)
+			assert.Equal(t, tt.want.steps[i].board, dto.BuildDisclosedBoard(steps[i].Board))
+		}
+	}
+}
+
+func TestDailyPlay(t *testing.T) {
+	// · Mocks · //
+
+	today := domain.Challenge{ID: "2021-10-01", Entries: []domain.ChallengeEntry{{Player: "alice", GameID: "1001"}}}
+
+	// · Tests · //
+
+	type args struct {
+		player string
+	}
+
+	type want struct {
+		result domain.Game
+		err    error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should create the daily game of the player successfully",
+			args: args{player: "bob"},
+			want: want{result: visible(dailyMockGame("1002", "2021-10-01"))},
+			mocks: func(m mocks) {
+				challengeToSave := domain.Challenge{ID: "2021-10-01", Entries: []domain.ChallengeEntry{{Player: "alice", GameID: "1001"}, {Player: "bob", GameID: "1002"}}}
+
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(today, nil)
+				m.uidGen.EXPECT().New().Return("1002")
+				m.gameRepository.EXPECT().Save(dailyMockGame("1002", "2021-10-01")).Return(nil)
+				m.challengeRepository.EXPECT().Save(challengeToSave).Return(nil)
+			},
+		},
+		{
+			name: "Should create the first daily game of the day successfully",
+			args: args{player: "alice"},
+			want: want{result: visible(dailyMockGame("1001", "2021-10-01"))},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(domain.Challenge{}, errors.New(apperrors.NotFound, nil, ""))
+				m.uidGen.EXPECT().New().Return("1001")
+				m.gameRepository.EXPECT().Save(dailyMockGame("1001", "2021-10-01")).Return(nil)
+				m.challengeRepository.EXPECT().Save(today).Return(nil)
+			},
+		},
+		{
+			name: "Should return the daily game the player already has",
+			args: args{player: "alice"},
+			want: want{result: withElapsed(visible(startedAt(dailyMockGame("1001", "2021-10-01"), testNow.Add(-time.Minute))), time.Minute)},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(today, nil)
+				m.gameRepository.EXPECT().Get("1001").Return(startedAt(dailyMockGame("1001", "2021-10-01"), testNow.Add(-time.Minute)), nil)
+			},
+		},
+		{
+			name:  "Should return an error - player missing",
+			args:  args{player: ""},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the player must be given")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name: "Should return an error - get challenge has fail",
+			args: args{player: "alice"},
+			want: want{err: errors.New(apperrors.Internal, nil, "get challenge from repository has failed")},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(domain.Challenge{}, errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - save game has fail",
+			args: args{player: "bob"},
+			want: want{err: errors.New(apperrors.Internal, nil, "create game into repository has failed")},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(today, nil)
+				m.uidGen.EXPECT().New().Return("1002")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - save challenge has fail",
+			args: args{player: "bob"},
+			want: want{err: errors.New(apperrors.Internal, nil, "save challenge into repository has failed")},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(today, nil)
+				m.uidGen.EXPECT().New().Return("1002")
+				m.gameRepository.EXPECT().Save(gomock.Any()).Return(nil)
+				m.challengeRepository.EXPECT().Save(gomock.Any()).Return(errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository:      mockups.NewMockGamesRepository(gomock.NewController(t)),
+			challengeRepository: mockups.NewMockChallengesRepository(gomock.NewController(t)),
+			uidGen:              mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:               mockups.NewMockClock(gomock.NewController(t)),
+			random:              mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		dailyUseCase := usecases.NewDaily(m.gameRepository, m.challengeRepository, m.uidGen, m.clock, testSecret)
+
+		// Execute
+		gameResult, err := dailyUseCase.Play(tt.args.player)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.result, gameResult)
+	}
+}
+
+func TestDailyPlaySharesTheBoard(t *testing.T) {
+	// Prepare
+	uidGen := mockups.NewMockUIDGen(gomock.NewController(t))
+	clock := mockups.NewMockClock(gomock.NewController(t))
+	gamesRepository := memory_kvs.NewMemKVS()
+
+	uidGen.EXPECT().New().Return("1001")
+	uidGen.EXPECT().New().Return("1002")
+	uidGen.EXPECT().New().Return("1003")
+	clock.EXPECT().Now().Return(testNow).Times(3)
+	clock.EXPECT().Now().Return(testNow.Add(24 * time.Hour)).Times(1)
+
+	dailyUseCase := usecases.NewDaily(gamesRepository, memory_kvs.NewChallengeMemKVS(), uidGen, clock, testSecret)
+
+	// Execute
+	alice, _ := dailyUseCase.Play("alice")
+	bob, _ := dailyUseCase.Play("bob")
+	aliceAgain, _ := dailyUseCase.Play("alice")
+	aliceTomorrow, _ := dailyUseCase.Play("alice")
+
+	// Verify
+	aliceGame, _ := gamesRepository.Get(alice.ID)
+	bobGame, _ := gamesRepository.Get(bob.ID)
+	tomorrowGame, _ := gamesRepository.Get(aliceTomorrow.ID)
+
+	assert.Equal(t, "1001", alice.ID)
+	assert.Equal(t, "1002", bob.ID)
+	assert.Equal(t, "1001", aliceAgain.ID)
+	assert.Equal(t, "1003", aliceTomorrow.ID)
+	assert.Equal(t, "2021-10-02", aliceTomorrow.Challenge)
+	assert.Equal(t, aliceGame.Board, bobGame.Board)
+	assert.NotEqual(t, aliceGame.Board, tomorrowGame.Board)
+	assert.Equal(t, uint(40), countMines(aliceGame.Board))
+	assert.True(t, aliceGame.Board.IsRevealed(8, 8))
+	assert.Equal(t, &domain.Position{Row: 8, Col: 8}, aliceGame.BoardSettings.Start)
+	assert.Equal(t, aliceGame.Board.ThreeBVLeft(), aliceGame.ThreeBV)
+	assert.Nil(t, aliceGame.BoardSettings.Seed)
+}
+
+func TestDailyResults(t *testing.T) {
+	// · Mocks · //
+
+	today := domain.Challenge{ID: "2021-10-01", Entries: []domain.ChallengeEntry{
+		{Player: "alice", GameID: "1001"},
+		{Player: "bob", GameID: "1002"},
+		{Player: "carol", GameID: "1003"},
+		{Player: "dave", GameID: "1004"},
+		{Player: "erin", GameID: "1005"},
+	}}
+
+	lost := finishedAt(startedAt(dailyMockGame("1001", "2021-10-01"), testNow.Add(-time.Hour)), testNow.Add(-time.Hour+30*time.Second))
+	lost.State = domain.GameStateLost
+
+	slow := finishedAt(startedAt(dailyMockGame("1002", "2021-10-01"), testNow.Add(-time.Hour)), testNow.Add(-time.Hour+90*time.Second))
+	slow.State = domain.GameStateWon
+	slow.ScoreWin()
+
+	fast := assisted(finishedAt(startedAt(dailyMockGame("1003", "2021-10-01"), testNow.Add(-time.Hour)), testNow.Add(-time.Hour+60*time.Second)))
+	fast.State = domain.GameStateWon
+	fast.ScoreWin()
+
+	playing := startedAt(dailyMockGame("1004", "2021-10-01"), testNow.Add(-10*time.Second))
+	playing.State = domain.GameStateInProgress
+
+	spared := withLives(finishedAt(startedAt(dailyMockGame("1005", "2021-10-01"), testNow.Add(-time.Hour)), testNow.Add(-time.Hour+45*time.Second)), 3)
+	spared.State = domain.GameStateWon
+	spared.ScoreWin()
+
+	// · Tests · //
+
+	type args struct {
+		id string
+	}
+
+	type want struct {
+		results []domain.ChallengeResult
+		err     error
+	}
+
+	tests := []struct {
+		name  string
+		args  args
+		want  want
+		mocks func(m mocks)
+	}{
+		{
+			name: "Should list the results of the day successfully - unaided wins first, then assisted or multi-life wins, fastest first",
+			args: args{id: "2021-10-01"},
+			want: want{results: []domain.ChallengeResult{
+				{Player: "bob", State: domain.GameStateWon, Elapsed: 90 * time.Second, Score: slow.Score},
+				{Player: "erin", State: domain.GameStateWon, Elapsed: 45 * time.Second, MultiLife: true, Score: spared.Score},
+				{Player: "carol", State: domain.GameStateWon, Elapsed: 60 * time.Second, Assisted: true, Score: fast.Score},
+				{Player: "alice", State: domain.GameStateLost, Elapsed: 30 * time.Second},
+				{Player: "dave", State: domain.GameStateInProgress, Elapsed: 10 * time.Second},
+			}},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(today, nil)
+				m.gameRepository.EXPECT().Get("1001").Return(lost, nil)
+				m.gameRepository.EXPECT().Get("1002").Return(slow, nil)
+				m.gameRepository.EXPECT().Get("1003").Return(fast, nil)
+				m.gameRepository.EXPECT().Get("1004").Return(playing, nil)
+				m.gameRepository.EXPECT().Get("1005").Return(spared, nil)
+			},
+		},
+		{
+			name: "Should list the results of a past day successfully - with the games",
+			args: args{id: "2021-09-30"},
+			want: want{results: []domain.ChallengeResult{
+				{Player: "bob", GameID: "1002", State: domain.GameStateWon, Elapsed: 90 * time.Second, Score: slow.Score},
+				{Player: "erin", GameID: "1005", State: domain.GameStateWon, Elapsed: 45 * time.Second, MultiLife: true, Score: spared.Score},
+				{Player: "carol", GameID: "1003", State: domain.GameStateWon, Elapsed: 60 * time.Second, Assisted: true, Score: fast.Score},
+				{Player: "alice", GameID: "1001", State: domain.GameStateLost, Elapsed: 30 * time.Second},
+				{Player: "dave", GameID: "1004", State: domain.GameStateInProgress, Elapsed: 10 * time.Second},
+			}},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-09-30").Return(domain.Challenge{ID: "2021-09-30", Entries: today.Entries}, nil)
+				m.gameRepository.EXPECT().Get("1001").Return(lost, nil)
+				m.gameRepository.EXPECT().Get("1002").Return(slow, nil)
+				m.gameRepository.EXPECT().Get("1003").Return(fast, nil)
+				m.gameRepository.EXPECT().Get("1004").Return(playing, nil)
+				m.gameRepository.EXPECT().Get("1005").Return(spared, nil)
+			},
+		},
+		{
+			name: "Should list no results - nobody played that day",
+			args: args{id: "2021-09-30"},
+			want: want{results: []domain.ChallengeResult{}},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-09-30").Return(domain.Challenge{}, errors.New(apperrors.NotFound, nil, ""))
+			},
+		},
+		{
+			name:  "Should return an error - invalid day",
+			args:  args{id: "yesterday"},
+			want:  want{err: errors.New(apperrors.InvalidInput, nil, "the challenge day must be written as YYYY-MM-DD")},
+			mocks: func(m mocks) {},
+		},
+		{
+			name: "Should return an error - get challenge has fail",
+			args: args{id: "2021-10-01"},
+			want: want{err: errors.New(apperrors.Internal, nil, "get challenge from repository has failed")},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(domain.Challenge{}, errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+		{
+			name: "Should return an error - get game has fail",
+			args: args{id: "2021-10-01"},
+			want: want{err: errors.New(apperrors.Internal, nil, "get game from repository has failed")},
+			mocks: func(m mocks) {
+				m.challengeRepository.EXPECT().Get("2021-10-01").Return(today, nil)
+				m.gameRepository.EXPECT().Get("1001").Return(domain.Game{}, errors.New(apperrors.Internal, nil, ""))
+			},
+		},
+	}
+
+	// · Runner · //
+
+	for _, tt := range tests {
+		tt := tt
+
+		// Prepare
+		m := mocks{
+			gameRepository:      mockups.NewMockGamesRepository(gomock.NewController(t)),
+			challengeRepository: mockups.NewMockChallengesRepository(gomock.NewController(t)),
+			uidGen:              mockups.NewMockUIDGen(gomock.NewController(t)),
+			clock:               mockups.NewMockClock(gomock.NewController(t)),
+			random:              mockups.NewMockRandom(gomock.NewController(t)),
+		}
+
+		m.clock.EXPECT().Now().Return(testNow).AnyTimes()
+		tt.mocks(m)
+		dailyUseCase := usecases.NewDaily(m.gameRepository, m.challengeRepository, m.uidGen, m.clock, testSecret)
+
+		// Execute
+		results, err := dailyUseCase.Results(tt.args.id)
+
+		// Verify
+		if tt.want.err != nil && err != nil {
+			assert.Equal(t, errors.Code(tt.want.err), errors.Code(err))
+			assert.Equal(t, tt.want.err.Error(), err.Error())
+		}
+
+		assert.Equal(t, tt.want.results, results)
+	}
+}
+
+type pos struct {
+	row uint
+	col uint
+}
+
+func easymockGame(id string, name string, size uint, state string, hideBombs bool, bombs []pos, revealed []pos) domain.Game {
+	seed := testSeed
+	game := domain.NewGame(id, name, domain.BoardSettings{Rows: size, Cols: size, Seed: &seed})
+	game.BoardSettings.Bombs = uint(len(bombs))
+	game.Seeded = true
+
+	for _, pos := range bombs {
+		game.Board.SetMine(pos.row, pos.col)
+	}
+
+	game.ThreeBV = game.Board.ThreeBV()
+
+	for _, pos := range revealed {
+		game.Board.Reveal(pos.row, pos.col)
+	}
+
+	if hideBombs {
+		game.Board = game.Board.HideBombs()
+	}
+
+	if state != "" {
+		game.State = state
+	}
+
+	return game
+}
+
+func withFlags(game domain.Game, flags []pos) domain.Game {
+	for _, pos := range flags {
+		game.Board.ToggleFlag(pos.row, pos.col)
+	}
+
+	return game
+}
+
+func withQuestions(game domain.Game, questions []pos) domain.Game {
+	for _, pos := range questions {
+		game.Board.Cells[pos.row][pos.col].Questioned = true
+	}
+
+	return game
+}
+
+func withExploded(game domain.Game, exploded pos) domain.Game {
+	game.Board.Explode(exploded.row, exploded.col)
+	game.Detonations = append(game.Detonations, domain.Position{Row: exploded.row, Col: exploded.col})
+
+	return game
+}
+
+func played(game domain.Game, move domain.Move) domain.Game {
+	if game.State == domain.GameStateNew {
+		game.State = domain.GameStateInProgress
+	}
+
+	move.At = testNow
+	move.State = game.State
+	game.Moves = append(game.Moves, move)
+	game.TrackTime(testNow)
+
+	if game.State == domain.GameStateWon {
+		game.ScoreWin()
+	}
+
+	return game
+}
+
+func pausedAt(game domain.Game, paused time.Time) domain.Game {
+	game.PauseAt(paused)
+
+	return game
+}
+
+func resumedAt(game domain.Game, resumed time.Time) domain.Game {
+	game.ResumeAt(resumed)
+
+	return game
+}
+
+func maskPaused(game domain.Game) domain.Game {
+	game.BoardSettings.MaskPaused = true
+
+	return game
+}
+
+// withListedMines records the bombs of the board as the mines listed when the game was created.
+// minedGame builds the same game as easymockGame, but the way clients do: created from its listed mines.
+func minedGame(t *testing.T, size uint, state string, hideBombs bool, bombs []pos, revealed []pos) domain.Game {
+	uidGen := mockups.NewMockUIDGen(gomock.NewController(t))
+	uidGen.EXPECT().New().Return("1001")
+	gamesRepository := memory_kvs.NewMemKVS()
+	gameUseCase := usecases.New(gamesRepository, uidGen, mockups.NewMockClock(gomock.NewController(t)), mockups.NewMockRandom(gomock.NewController(t)))
+
+	seed := testSeed
+	settings := withListedMines(domain.Game{}, bombs).BoardSettings
+	settings.Rows, settings.Cols, settings.Seed = size, size, &seed
+
+	created, err := gameUseCase.Create("mygame", settings)
+	assert.Nil(t, err)
+
+	game, _ := gamesRepository.Get(created.ID)
+
+	for _, pos := range revealed {
+		game.Board.Reveal(pos.row, pos.col)
 	}
 
 	if hideBombs {
@@ -354,3 +2529,79 @@ func easymockGame(id string, name string, size uint, state string, hideBombs boo
 
 	return game
 }
+
+func withListedMines(game domain.Game, mines []pos) domain.Game {
+	game.BoardSettings.Mines = nil
+
+	for _, mine := range mines {
+		game.BoardSettings.Mines = append(game.BoardSettings.Mines, domain.Position{Row: mine.row, Col: mine.col})
+	}
+
+	return game
+}
+
+// dailyMockGame lays the intermediate board of the challenge from the test secret, with its center opened.
+func dailyMockGame(id string, challenge string) domain.Game {
+	seed := domain.ChallengeSeed(testSecret, challenge)
+	start := domain.Position{Row: 8, Col: 8}
+	settings := domain.BoardSettings{Rows: 16, Cols: 16, Bombs: 40, Seed: &seed, Difficulty: domain.DifficultyIntermediate}
+
+	game := domain.NewGame(id, "daily "+challenge, settings)
+	game.Challenge = challenge
+	game.Seed(start.Row, start.Col)
+	game.OpenStart(start)
+	game.BoardSettings.Seed = nil
+
+	return game
+}
+
+func withLives(game domain.Game, lives uint) domain.Game {
+	game.BoardSettings.Lives = lives
+
+	return game
+}
+
+func visible(game domain.Game) domain.Game {
+	game.Board = game.VisibleBoard()
+
+	return game
+}
+
+func assisted(game domain.Game) domain.Game {
+	game.Assisted = true
+
+	return game
+}
+
+func practice(game domain.Game) domain.Game {
+	game.BoardSettings.Practice = true
+
+	return game
+}
+
+// unseeded keeps the bombs count of a board whose bombs are not placed yet.
+func unseeded(game domain.Game, bombs []pos) domain.Game {
+	game.BoardSettings.Bombs = uint(len(bombs))
+	game.Seeded = false
+	game.ThreeBV = 0
+
+	return game
+}
+
+func startedAt(game domain.Game, started time.Time) domain.Game {
+	game.StartedAt = &started
+
+	return game
+}
+
+func finishedAt(game domain.Game, finished time.Time) domain.Game {
+	game.FinishedAt = &finished
+
+	return game
+}
+
+func withElapsed(game domain.Game, elapsed time.Duration) domain.Game {
+	game.Elapsed = elapsed
+
+	return game
+}